@@ -0,0 +1,17 @@
+package cln
+
+// An ApproxSet is a probabilistic set, such as a bloom filter or a cuckoo filter, that trades exact answers for a small,
+// fixed memory footprint. Contains never returns false for an element that was added, but may return true for one that
+// was not, with a probability set when the structure is created.
+type ApproxSet[T comparable] interface {
+	// Adds element(s) to the set. Returns an error if an element could not be added because the set is full.
+	Add(vals ...T) error
+	// Returns false if the given element was definitely never added, and true if it might have been.
+	Contains(val T) bool
+	// Returns an estimate of the amount of elements in the set.
+	EstimatedCount() int
+	// Removes all elements from the set.
+	Clear()
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary(data []byte) error
+}
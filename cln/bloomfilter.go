@@ -0,0 +1,219 @@
+package cln
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// The binary encoding of a bloom filter starts with bloomMagic followed by a version byte. Version 1 continues with the
+// amount of hash functions as a uint32, the amount of bits as a uint64 and the bits themselves as uint64 words, all
+// little endian.
+const (
+	bloomMagic   = "clnB"
+	bloomVersion = 1
+)
+
+// The most hash functions a bloom filter uses, which is only reached by false-positive rates below 10^-19 and keeps
+// decoded filters from spending arbitrarily long on each element.
+const maxBloomHashes = 64
+
+// A bloomFilter is a probabilistic set: it answers whether an element might have been added, using a fixed amount of
+// memory however many elements are added. An element that was added is always reported as possibly present, but an
+// element that was never added is also reported as present with a small probability - the false-positive rate - that
+// grows as elements are added.
+//
+// Each element sets k bits of an m bit array, chosen by k hash functions derived from a single 64 bit hash by double
+// hashing. m and k are picked from the amount of elements the filter is expected to hold and the false-positive rate
+// wanted once it holds them. Elements can't be removed; see NewCuckooFilter for a filter that supports deletion.
+//
+// A bloom filter is not safe for concurrent use; wrap it with a mutex if it is shared between goroutines.
+type bloomFilter[T comparable] struct {
+	bits []uint64
+	m    uint64 // the amount of bits
+	k    int    // the amount of hash functions
+	hash func(val T) uint64
+}
+
+// Returns a new, empty bloom filter sized to hold the expected amount of elements with the given false-positive rate,
+// such as 0.01 for 1%. Elements are hashed by stableHash, which says when filters can be shared between processes.
+func NewBloomFilter[T comparable](expected int, falsePositiveRate float64) *bloomFilter[T] {
+	return NewBloomFilterWithHasher(expected, falsePositiveRate, stableHash[T])
+}
+
+// Returns a new, empty bloom filter sized to hold the expected amount of elements with the given false-positive rate,
+// using the given hash function in place of stableHash. Filters are only merged and deserialized correctly by filters
+// using the same function.
+func NewBloomFilterWithHasher[T comparable](expected int, falsePositiveRate float64,
+	hash func(val T) uint64) *bloomFilter[T] {
+	if expected < 1 {
+		expected = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		panic(fmt.Sprintf("cln: bloom filter false-positive rate %v is not between 0 and 1", falsePositiveRate))
+	}
+
+	m := uint64(math.Ceil(-float64(expected) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	k := int(math.Round(float64(m) / float64(expected) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	if k > maxBloomHashes {
+		k = maxBloomHashes
+	}
+
+	return newBloomFilter(m, k, hash)
+}
+
+// Returns a new, empty bloom filter of m bits and k hash functions.
+func newBloomFilter[T comparable](m uint64, k int, hash func(val T) uint64) *bloomFilter[T] {
+	if m < 64 {
+		m = 64
+	}
+
+	return &bloomFilter[T]{bits: make([]uint64, wordsFor(m)), m: m, k: k, hash: hash}
+}
+
+// Returns the amount of uint64 words that hold m bits, without overflowing for any m.
+func wordsFor(m uint64) uint64 {
+	words := m / 64
+	if m%64 != 0 {
+		words++
+	}
+
+	return words
+}
+
+// Calls f with the index of each of the k bits of the given element.
+func (bf *bloomFilter[T]) each(val T, f func(i uint64)) {
+	h1 := bf.hash(val)
+	h2 := mix64(h1) | 1 // odd, so that the k indexes differ
+	for i := 0; i < bf.k; i++ {
+		f((h1 + uint64(i)*h2) % bf.m)
+	}
+}
+
+// Adds element(s) to the filter. A bloom filter never runs out of room, so the returned error is always nil; past the
+// expected amount of elements the false-positive rate grows instead.
+func (bf *bloomFilter[T]) Add(vals ...T) error {
+	for _, val := range vals {
+		bf.each(val, func(i uint64) {
+			bf.bits[i/64] |= 1 << (i % 64)
+		})
+	}
+
+	return nil
+}
+
+// Returns false if the given element was definitely never added to the filter, and true if it might have been.
+func (bf *bloomFilter[T]) MightContain(val T) bool {
+	contains := true
+	bf.each(val, func(i uint64) {
+		contains = contains && bf.bits[i/64]&(1<<(i%64)) != 0
+	})
+
+	return contains
+}
+
+// Returns the same as MightContain, so that a bloom filter satisfies ApproxSet.
+func (bf *bloomFilter[T]) Contains(val T) bool {
+	return bf.MightContain(val)
+}
+
+// Adds every element of the other filter to the filter, so that it might contain everything either filter might
+// contain. Returns an error, leaving the filter unchanged, if the filters differ in size or amount of hash functions.
+// Both filters must use the same hash function.
+func (bf *bloomFilter[T]) Union(other *bloomFilter[T]) error {
+	if bf.m != other.m || bf.k != other.k {
+		return fmt.Errorf("cln: can't union a bloom filter of %d bits and %d hashes with one of %d bits and %d hashes",
+			bf.m, bf.k, other.m, other.k)
+	}
+
+	for i, w := range other.bits {
+		bf.bits[i] |= w
+	}
+	return nil
+}
+
+// Returns an estimate of the amount of distinct elements added to the filter, derived from the amount of bits set.
+func (bf *bloomFilter[T]) EstimatedCount() int {
+	set := bf.setBits()
+	if set == bf.m {
+		return math.MaxInt
+	}
+
+	m := float64(bf.m)
+	return int(math.Round(-m / float64(bf.k) * math.Log(1-float64(set)/m)))
+}
+
+// Returns the probability that MightContain returns true for an element that was never added, given the bits set so
+// far.
+func (bf *bloomFilter[T]) FalsePositiveRate() float64 {
+	return math.Pow(float64(bf.setBits())/float64(bf.m), float64(bf.k))
+}
+
+// Returns the amount of bits set.
+func (bf *bloomFilter[T]) setBits() uint64 {
+	set := 0
+	for _, w := range bf.bits {
+		set += bits.OnesCount64(w)
+	}
+
+	return uint64(set)
+}
+
+// Removes all elements from the filter.
+func (bf *bloomFilter[T]) Clear() {
+	for i := range bf.bits {
+		bf.bits[i] = 0
+	}
+}
+
+// Returns the binary encoding of the filter.
+func (bf *bloomFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(bloomMagic)+1+4+8+8*len(bf.bits))
+	data = append(data, bloomMagic...)
+	data = append(data, bloomVersion)
+	data = binary.LittleEndian.AppendUint32(data, uint32(bf.k))
+	data = binary.LittleEndian.AppendUint64(data, bf.m)
+	for _, w := range bf.bits {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+
+	return data, nil
+}
+
+// Replaces the filter with the one held by the given binary encoding, taking on its size and amount of hash functions.
+// The filter keeps its hash function, or uses the default one if it is the zero value.
+func (bf *bloomFilter[T]) UnmarshalBinary(data []byte) error {
+	header := len(bloomMagic) + 1 + 4 + 8
+	if len(data) < header || string(data[:len(bloomMagic)]) != bloomMagic {
+		return errors.New("cln: data is not an encoded bloom filter")
+	}
+	if version := data[len(bloomMagic)]; version != bloomVersion {
+		return fmt.Errorf("cln: unsupported bloom filter encoding version %d", version)
+	}
+
+	k := binary.LittleEndian.Uint32(data[len(bloomMagic)+1:])
+	m := binary.LittleEndian.Uint64(data[len(bloomMagic)+5:])
+	words := data[header:]
+	if k == 0 || k > maxBloomHashes {
+		return fmt.Errorf("cln: encoded bloom filter has %d hashes, not between 1 and %d", k, maxBloomHashes)
+	}
+	if m == 0 || uint64(len(words))/8 != wordsFor(m) || len(words)%8 != 0 {
+		return fmt.Errorf("cln: bloom filter of %d bits and %d hashes can't be held by %d bytes", m, k, len(words))
+	}
+
+	bf.bits = make([]uint64, len(words)/8)
+	for i := range bf.bits {
+		bf.bits[i] = binary.LittleEndian.Uint64(words[8*i:])
+	}
+	bf.m, bf.k = m, int(k)
+	if bf.hash == nil {
+		bf.hash = stableHash[T]
+	}
+
+	return nil
+}
@@ -0,0 +1,482 @@
+package cln
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The amount of elements held by each chunk of a chunkedQueue.
+const chunkSize = 64
+
+// A chunkedQueue is a FIFO queue, like queue, whose nodes - chunks - each hold up to chunkSize elements in a fixed size
+// array. Elements are added after the last element of the 'tail' chunk and taken from the first element of the 'head'
+// chunk.
+//
+// Storing many elements per node keeps Add and Take O(1) (amortized) and memory O(n), while allocating once per
+// chunkSize elements instead of once per element, which greatly reduces the work done by the garbage collector. The
+// tradeoff is that a queue holding few elements still holds a whole chunk.
+type chunkedQueue[T comparable] struct {
+	head    *chunk[T]
+	tail    *chunk[T]
+	headPos int // index of the first element in head
+	tailPos int // index one past the last element in tail
+	size    int
+}
+
+// A chunk is a node of a chunkedQueue holding a fixed amount of elements and a reference to the following chunk.
+type chunk[T comparable] struct {
+	vals [chunkSize]T
+	next *chunk[T]
+}
+
+// Returns a new instance of a chunked queue of the specified type.
+func NewChunkedQueue[T comparable]() *chunkedQueue[T] {
+	return &chunkedQueue[T]{}
+}
+
+// Returns a new chunked queue holding the given elements, from head to tail.
+func ChunkedQueueOf[T comparable](vals ...T) *chunkedQueue[T] {
+	q := NewChunkedQueue[T]()
+	q.Add(vals...)
+	return q
+}
+
+// Adds element(s) to the tail-end of the queue.
+func (q *chunkedQueue[T]) Add(vals ...T) {
+	if debug {
+		defer mustValidate(q)
+	}
+
+	for _, elem := range vals {
+		if q.tail == nil {
+			q.head = &chunk[T]{}
+			q.tail = q.head
+		} else if q.tailPos == chunkSize {
+			q.tail.next = &chunk[T]{}
+			q.tail = q.tail.next
+			q.tailPos = 0
+		}
+
+		q.tail.vals[q.tailPos] = elem
+		q.tailPos++
+		q.size++
+	}
+}
+
+// Adds all elements of the given collection, in the other collection's iteration order, to the queue.
+func (q *chunkedQueue[T]) AddAll(other Collection[T]) {
+	q.Add(values(other)...)
+}
+
+// Returns the value of the head of the queue and removes it along with a bool value of true if the queue is not empty,
+// otherwise, it will return the zero value of the queue's type and a bool value of false.
+func (q *chunkedQueue[T]) Take() (T, bool) {
+	if debug {
+		defer mustValidate(q)
+	}
+
+	var zero T
+	if q.size == 0 {
+		return zero, false
+	}
+
+	val := q.head.vals[q.headPos]
+	q.head.vals[q.headPos] = zero
+	q.headPos++
+	q.size--
+
+	if q.size == 0 {
+		q.headPos, q.tailPos = 0, 0
+	} else if q.headPos == chunkSize {
+		q.head = q.head.next
+		q.headPos = 0
+	}
+
+	return val, true
+}
+
+// Returns the value of the head of the queue but does not remove it. If the queue is empty, returns the zero value of
+// the queue's type and false.
+func (q *chunkedQueue[T]) Peek() (T, bool) {
+	if q.size == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return q.head.vals[q.headPos], true
+}
+
+// Removes all elements from the queue.
+func (q *chunkedQueue[T]) Clear() {
+	if debug {
+		defer mustValidate(q)
+	}
+
+	*q = chunkedQueue[T]{}
+}
+
+// Calls f with every element of the queue, from head to tail, until f returns false.
+func (q *chunkedQueue[T]) each(f func(val T) bool) {
+	for c := q.head; c != nil; c = c.next {
+		start, end := 0, chunkSize
+		if c == q.head {
+			start = q.headPos
+		}
+		if c == q.tail {
+			end = q.tailPos
+		}
+
+		for i := start; i < end; i++ {
+			if !f(c.vals[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Returns true if the queue contains the given element, returns false otherwise.
+func (q *chunkedQueue[T]) Contains(val T) bool {
+	return q.IndexOf(val) != -1
+}
+
+// Returns true if the queue contains every one of the given elements, returns false otherwise.
+func (q *chunkedQueue[T]) ContainsAll(vals ...T) bool {
+	return containsAll[T](q, vals)
+}
+
+// Returns true if the queue contains at least one of the given elements, returns false otherwise.
+func (q *chunkedQueue[T]) ContainsAny(vals ...T) bool {
+	return containsAny[T](q, vals)
+}
+
+// Returns the index, counting from the head, of the first instance of the given element in the queue. Returns -1 if
+// the queue does not contain the element.
+func (q *chunkedQueue[T]) IndexOf(val T) int {
+	found := -1
+	i := 0
+	q.each(func(v T) bool {
+		if v == val {
+			found = i
+			return false
+		}
+		i++
+		return true
+	})
+
+	return found
+}
+
+// Returns the index, counting from the head, of the last instance of the given element in the queue. Returns -1 if
+// the queue does not contain the element.
+func (q *chunkedQueue[T]) LastIndexOf(val T) int {
+	last := -1
+	i := 0
+	q.each(func(v T) bool {
+		if v == val {
+			last = i
+		}
+		i++
+		return true
+	})
+
+	return last
+}
+
+// Removes the first instance of the given element from the queue. Returns true if an element was removed, returns false
+// otherwise. Elements behind the removed one are shifted towards the head, so this is O(n).
+func (q *chunkedQueue[T]) Remove(val T) bool {
+	target := q.IndexOf(val)
+	if target == -1 {
+		return false
+	}
+
+	i := 0
+	q.RemoveIf(func(T) bool {
+		remove := i == target
+		i++
+		return remove
+	})
+
+	return true
+}
+
+// Removes every instance of each of the given elements from the queue and returns the amount of elements removed.
+func (q *chunkedQueue[T]) RemoveAll(vals ...T) int {
+	if len(vals) == 0 {
+		return 0
+	}
+
+	return q.RemoveIf(memberOf(vals))
+}
+
+// Removes every instance of the given element from the queue and returns the amount of elements removed.
+func (q *chunkedQueue[T]) RemoveEvery(val T) int {
+	return q.RemoveIf(func(v T) bool {
+		return v == val
+	})
+}
+
+// Removes all elements from the queue that are not contained in the given collection and returns the amount of
+// elements removed.
+func (q *chunkedQueue[T]) RetainAll(other Collection[T]) int {
+	return q.RetainIf(memberOf(values(other)))
+}
+
+// Filters all elements from the queue that satisfy the given predicate.
+//
+// Deprecated: Filter removes the elements that satisfy the predicate, which is the opposite of what most expect from
+// a filter. Use RemoveIf, RetainIf or Where instead.
+func (q *chunkedQueue[T]) Filter(filter func(val T) bool) {
+	q.RemoveIf(filter)
+}
+
+// Removes all elements from the queue that satisfy the given predicate and returns the amount of elements removed.
+// Remaining elements are compacted towards the head and chunks left empty are released.
+func (q *chunkedQueue[T]) RemoveIf(pred func(val T) bool) int {
+	if debug {
+		defer mustValidate(q)
+	}
+
+	if q.head == nil {
+		return 0
+	}
+
+	w, wPos := q.head, q.headPos
+	removed := 0
+	q.each(func(v T) bool {
+		if pred(v) {
+			removed++
+			return true
+		}
+
+		if wPos == chunkSize {
+			w, wPos = w.next, 0
+		}
+		w.vals[wPos] = v
+		wPos++
+		return true
+	})
+
+	var zero T
+	for i := wPos; i < chunkSize; i++ {
+		w.vals[i] = zero
+	}
+
+	w.next = nil
+	q.tail, q.tailPos = w, wPos
+	q.size -= removed
+	if q.size == 0 {
+		q.headPos, q.tailPos = 0, 0
+	}
+
+	return removed
+}
+
+// Removes all elements from the queue that do not satisfy the given predicate and returns the amount of elements removed.
+func (q *chunkedQueue[T]) RetainIf(pred func(val T) bool) int {
+	return q.RemoveIf(func(val T) bool {
+		return !pred(val)
+	})
+}
+
+// Returns a new chunked queue containing, in order, the elements of the queue that satisfy the given predicate. The
+// queue itself is left unchanged.
+func (q *chunkedQueue[T]) Where(pred func(val T) bool) Collection[T] {
+	matches := NewChunkedQueue[T]()
+	q.each(func(v T) bool {
+		if pred(v) {
+			matches.Add(v)
+		}
+		return true
+	})
+
+	return matches
+}
+
+// Returns the amount of elements contained within the queue.
+func (q *chunkedQueue[T]) Size() int {
+	return q.size
+}
+
+// Returns true if the queue contains no elements, otherwise returns false.
+func (q *chunkedQueue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// Returns an error describing the first broken invariant of the queue, or nil if the queue is consistent. The size must
+// match the amount of elements held between the head and tail positions, the tail must be the last chunk reachable
+// from the head, and every chunk between them must be full.
+func (q *chunkedQueue[T]) Validate() error {
+	if q.size < 0 {
+		return fmt.Errorf("chunked queue: negative size %d", q.size)
+	}
+
+	if q.head == nil {
+		if q.tail != nil {
+			return errors.New("chunked queue: tail is set but head is nil")
+		}
+		if q.size != 0 || q.headPos != 0 || q.tailPos != 0 {
+			return fmt.Errorf("chunked queue: head is nil but size is %d and positions are %d, %d", q.size, q.headPos,
+				q.tailPos)
+		}
+		return nil
+	}
+
+	if q.tail == nil {
+		return errors.New("chunked queue: head is set but tail is nil")
+	}
+	if q.tail.next != nil {
+		return errors.New("chunked queue: tail is not the last chunk")
+	}
+	if q.headPos < 0 || q.headPos >= chunkSize || q.tailPos < 0 || q.tailPos > chunkSize {
+		return fmt.Errorf("chunked queue: positions %d, %d are out of range", q.headPos, q.tailPos)
+	}
+	if q.head == q.tail && q.headPos > q.tailPos {
+		return fmt.Errorf("chunked queue: head position %d is past tail position %d", q.headPos, q.tailPos)
+	}
+
+	count := chunkSize - q.headPos
+	last := q.head
+	for last.next != nil {
+		if count > q.size {
+			return fmt.Errorf("chunked queue: more elements are reachable from head than size %d", q.size)
+		}
+		last = last.next
+		count += chunkSize
+	}
+	count -= chunkSize - q.tailPos
+
+	if last != q.tail {
+		return errors.New("chunked queue: tail is not reachable from head")
+	}
+	if count != q.size {
+		return fmt.Errorf("chunked queue: size is %d but %d elements are held", q.size, count)
+	}
+
+	return nil
+}
+
+// Returns the structure of the queue: its chunks from head to tail, each labeled with the range of positions it holds
+// elements in and those elements, and the head and tail pointers along with their positions.
+func (q *chunkedQueue[T]) Structure() Structure {
+	s := Structure{Name: "chunkedQueue"}
+
+	var head, tail, prev string
+	for c, i := q.head, 0; c != nil; c, i = c.next, i+1 {
+		start, end := 0, chunkSize
+		if c == q.head {
+			start = q.headPos
+		}
+		if c == q.tail {
+			end = q.tailPos
+		}
+
+		label := fmt.Sprintf("[%d:%d]", start, end)
+		for _, v := range c.vals[start:end] {
+			label += " " + fmt.Sprint(v)
+		}
+
+		id := s.addNode(fmt.Sprintf("chunk%d", i), label, ElementNode, "chunks")
+		if prev != "" {
+			s.addEdge(prev, id, "next")
+		}
+		if c == q.head {
+			head = id
+		}
+		if c == q.tail {
+			tail = id
+			break
+		}
+		prev = id
+	}
+
+	s.addPointer("head", fmt.Sprintf("head (pos %d)", q.headPos), head)
+	s.addPointer("tail", fmt.Sprintf("tail (pos %d)", q.tailPos), tail)
+	return s
+}
+
+// Returns a string representation of the queue. The larger the queue, the more expensive the operation.
+func (q *chunkedQueue[T]) String() string {
+	var stringBuilder strings.Builder
+
+	i := 0
+	q.each(func(v T) bool {
+		if i > 0 {
+			stringBuilder.WriteString(" -> ")
+		}
+		stringBuilder.WriteString(fmt.Sprint(v))
+		i++
+		return true
+	})
+
+	return stringBuilder.String()
+}
+
+// Formats the queue for the fmt package. %v prints its elements from head to tail, %+v also prints its size, head and
+// tail, and %#v prints a call to ChunkedQueueOf that rebuilds it. Long queues are truncated; see FormatLimit.
+func (q *chunkedQueue[T]) Format(f fmt.State, verb rune) {
+	formatCollection(f, verb, layout{"chunkedQueue", "head", "tail", "cln.ChunkedQueueOf"}, q.size, q.each)
+}
+
+// Returns the queue encoded as a JSON array of its elements, from head to tail.
+func (q *chunkedQueue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(values[T](q))
+}
+
+// Replaces the elements of the queue with those of the given JSON array, restoring the order produced by MarshalJSON.
+func (q *chunkedQueue[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONInto[T](q, data)
+}
+
+// Writes the binary encoding of the queue to w, one element at a time, from head to tail.
+func (q *chunkedQueue[T]) EncodeTo(w io.Writer) error {
+	return encodeCollection(w, q.size, q.each)
+}
+
+// Replaces the elements of the queue with those read from r, which must hold an encoding written by EncodeTo. On error
+// the queue holds the elements decoded so far.
+func (q *chunkedQueue[T]) DecodeFrom(r io.Reader) error {
+	q.Clear()
+	return decodeCollection(r, func(val T) {
+		q.Add(val)
+	})
+}
+
+// Returns the binary encoding of the queue, as written by EncodeTo.
+func (q *chunkedQueue[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := q.EncodeTo(&buf)
+	return buf.Bytes(), err
+}
+
+// Replaces the elements of the queue with those of the given binary encoding.
+func (q *chunkedQueue[T]) UnmarshalBinary(data []byte) error {
+	return q.DecodeFrom(bytes.NewReader(data))
+}
+
+// Returns the binary encoding of the queue, for use by encoding/gob.
+func (q *chunkedQueue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// Replaces the elements of the queue with those of the given gob encoding.
+func (q *chunkedQueue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// Returns a chan of the same type of the collection
+func (q *chunkedQueue[T]) Iter() chan T {
+	c := make(chan T)
+	go func() {
+		q.each(func(v T) bool {
+			c <- v
+			return true
+		})
+		close(c)
+	}()
+	return c
+}
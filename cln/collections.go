@@ -1,15 +1,131 @@
 package cln
 
+import "encoding/json"
+
 // A generic Collection interface for common data structures. The size of the interface is subject to change as interface composition changes/improves overtime.
 type Collection[T comparable] interface {
 	Add(vals ...T)
+	AddAll(other Collection[T])
 	Take() (T, bool)
 	Contains(val T) bool
-	Remove(val T)
+	ContainsAll(vals ...T) bool
+	ContainsAny(vals ...T) bool
+	IndexOf(val T) int
+	LastIndexOf(val T) int
+	Remove(val T) bool
+	RemoveAll(vals ...T) int
+	RemoveEvery(val T) int
+	RetainAll(other Collection[T]) int
 	Filter(filter func(v T) bool)
+	RemoveIf(pred func(v T) bool) int
+	RetainIf(pred func(v T) bool) int
+	Where(pred func(v T) bool) Collection[T]
 	Clear()
 	Size() int
 	IsEmpty() bool
 	Iter() chan T
 	String() string
+	Validate() error
+}
+
+// Panics if the given collection's invariants do not hold. Mutating methods defer a call to this when the package is
+// built with the cln_debug tag.
+func mustValidate(c interface{ Validate() error }) {
+	if err := c.Validate(); err != nil {
+		panic(err)
+	}
+}
+
+// The amount of values above which bulk operations build a set for lookups rather than scanning linearly.
+const setThreshold = 8
+
+// Returns all values of the given collection in iteration order.
+func values[T comparable](c Collection[T]) []T {
+	vals := make([]T, 0, c.Size())
+	for v := range c.Iter() {
+		vals = append(vals, v)
+	}
+
+	return vals
+}
+
+// Returns a predicate that reports whether a value is one of the given values. When there are many values, lookups are
+// done against a set instead of scanning the slice.
+func memberOf[T comparable](vals []T) func(val T) bool {
+	if len(vals) <= setThreshold {
+		return func(val T) bool {
+			for _, v := range vals {
+				if v == val {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	set := make(map[T]struct{}, len(vals))
+	for _, v := range vals {
+		set[v] = struct{}{}
+	}
+
+	return func(val T) bool {
+		_, ok := set[val]
+		return ok
+	}
+}
+
+// Returns true if the given collection contains every one of the given values. A few values are looked up one by one
+// with Contains; for many, the collection's elements are copied into a set once.
+func containsAll[T comparable](c Collection[T], vals []T) bool {
+	if len(vals) <= setThreshold {
+		for _, v := range vals {
+			if !c.Contains(v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	contains := memberOf(values(c))
+	for _, v := range vals {
+		if !contains(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Returns true if the given collection contains at least one of the given values, looking them up the same way as
+// containsAll.
+func containsAny[T comparable](c Collection[T], vals []T) bool {
+	if len(vals) <= setThreshold {
+		for _, v := range vals {
+			if c.Contains(v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	contains := memberOf(values(c))
+	for _, v := range vals {
+		if contains(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Replaces the elements of the given collection with those of the given JSON array, added in the array's order.
+func unmarshalJSONInto[T comparable](c Collection[T], data []byte) error {
+	var vals []T
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+
+	c.Clear()
+	c.Add(vals...)
+	return nil
 }
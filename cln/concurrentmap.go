@@ -0,0 +1,274 @@
+package cln
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+// The amount of shards used by NewConcurrentMap.
+const defaultShards = 32
+
+// The size of a cache line on most processors, which a shard is padded to.
+const cacheLineSize = 64
+
+// A concurrentMap is a map that is safe for use by many goroutines. Keys are spread by their hash over a fixed amount of
+// shards, each a plain map guarded by its own sync.RWMutex, so that goroutines working on keys in different shards
+// never wait on each other. Unlike sync.Map it is typed, and it holds up under write heavy loads.
+type concurrentMap[K comparable, V any] struct {
+	shards []shard[K, V]
+	mask   uint64
+	hash   func(key K) uint64
+}
+
+// A shard is a lock guarded portion of a concurrentMap. It is padded to a multiple of cacheLineSize bytes so that
+// neighbouring shards don't share a cache line.
+type shard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+	_  [cacheLineSize - (unsafe.Sizeof(sync.RWMutex{})+unsafe.Sizeof(map[int]int(nil)))%cacheLineSize]byte
+}
+
+// Returns a new, empty concurrent map with a default amount of shards and a default hash function, which handles
+// strings and numbers quickly and hashes other keys field by field; see defaultHash.
+func NewConcurrentMap[K comparable, V any]() *concurrentMap[K, V] {
+	return NewConcurrentMapWithHasher[K, V](defaultShards, defaultHash[K])
+}
+
+// Returns a new, empty concurrent map spread over the given amount of shards, rounded up to a power of two, using the
+// given hash function to pick a key's shard. Equal keys must have equal hashes; the better the hash spreads distinct
+// keys, the less goroutines contend.
+func NewConcurrentMapWithHasher[K comparable, V any](shards int, hash func(key K) uint64) *concurrentMap[K, V] {
+	n := 1
+	for n < shards {
+		n <<= 1
+	}
+
+	cm := &concurrentMap[K, V]{shards: make([]shard[K, V], n), mask: uint64(n - 1), hash: hash}
+	for i := range cm.shards {
+		cm.shards[i].m = make(map[K]V)
+	}
+
+	return cm
+}
+
+// Returns the shard responsible for the given key.
+func (cm *concurrentMap[K, V]) shardOf(key K) *shard[K, V] {
+	return &cm.shards[cm.hash(key)&cm.mask]
+}
+
+// Returns the value stored for the given key and true, or the zero value and false if there is none.
+func (cm *concurrentMap[K, V]) Load(key K) (V, bool) {
+	s := cm.shardOf(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	val, ok := s.m[key]
+	return val, ok
+}
+
+// Stores the value for the given key, replacing any previous value.
+func (cm *concurrentMap[K, V]) Store(key K, val V) {
+	s := cm.shardOf(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.m[key] = val
+}
+
+// Returns the value stored for the given key and true if there is one. Otherwise, stores the given value and returns it
+// along with false.
+func (cm *concurrentMap[K, V]) LoadOrStore(key K, val V) (V, bool) {
+	s := cm.shardOf(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if actual, ok := s.m[key]; ok {
+		return actual, true
+	}
+
+	s.m[key] = val
+	return val, false
+}
+
+// Atomically updates the value of the given key. f receives the current value and whether there is one, and returns
+// the new value and whether to keep it; when keep is false the key is deleted. Returns what f returned. f is called
+// while the key's shard is locked, so it must not use the map.
+func (cm *concurrentMap[K, V]) Compute(key K, f func(old V, loaded bool) (val V, keep bool)) (V, bool) {
+	s := cm.shardOf(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, loaded := s.m[key]
+	val, keep := f(old, loaded)
+	if keep {
+		s.m[key] = val
+	} else {
+		delete(s.m, key)
+	}
+
+	return val, keep
+}
+
+// Deletes the value stored for the given key, if any.
+func (cm *concurrentMap[K, V]) Delete(key K) {
+	s := cm.shardOf(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.m, key)
+}
+
+// Calls f for every key and value in the map until f returns false. Each shard is copied under its read lock and f is
+// called on the copy, so f may use the map; entries changed while Range runs may or may not be seen.
+func (cm *concurrentMap[K, V]) Range(f func(key K, val V) bool) {
+	type entry struct {
+		key K
+		val V
+	}
+
+	var entries []entry
+	for i := range cm.shards {
+		s := &cm.shards[i]
+		entries = entries[:0]
+
+		s.mu.RLock()
+		for k, v := range s.m {
+			entries = append(entries, entry{k, v})
+		}
+		s.mu.RUnlock()
+
+		for _, e := range entries {
+			if !f(e.key, e.val) {
+				return
+			}
+		}
+	}
+}
+
+// Returns the amount of keys in the map. Shards are counted one after the other, so under concurrent writes the result
+// is an estimate.
+func (cm *concurrentMap[K, V]) Size() int {
+	size := 0
+	for i := range cm.shards {
+		s := &cm.shards[i]
+		s.mu.RLock()
+		size += len(s.m)
+		s.mu.RUnlock()
+	}
+
+	return size
+}
+
+// The seed used by defaultHash, fixed for the life of the process so that equal keys always hash equally.
+var hashSeed = maphash.MakeSeed()
+
+// Returns a hash of the given key that is equal for keys that are equal by ==. Strings, integers and floats are hashed
+// directly; other keys are hashed by their contents, which is slower. See appendKey.
+func defaultHash[K comparable](key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(hashSeed, k)
+	case int:
+		return mix64(uint64(k))
+	case int8:
+		return mix64(uint64(k))
+	case int16:
+		return mix64(uint64(k))
+	case int32:
+		return mix64(uint64(k))
+	case int64:
+		return mix64(uint64(k))
+	case uint:
+		return mix64(uint64(k))
+	case uint8:
+		return mix64(uint64(k))
+	case uint16:
+		return mix64(uint64(k))
+	case uint32:
+		return mix64(uint64(k))
+	case uint64:
+		return mix64(k)
+	case uintptr:
+		return mix64(uint64(k))
+	case float32:
+		return mix64(uint64(math.Float32bits(k + 0)))
+	case float64:
+		return mix64(math.Float64bits(k + 0))
+	default:
+		return maphash.Bytes(hashSeed, appendKey(nil, reflect.ValueOf(&key).Elem()))
+	}
+}
+
+// Appends bytes identifying the given comparable value to buf, such that values equal by == append equal bytes:
+//
+//   - pointers, channels and unsafe pointers append their address, not what they point to, since that is what ==
+//     compares
+//   - floats append their bits, with -0 taken as +0 since the two are equal
+//   - strings append their length and bytes, so that adjacent strings can't run into each other
+//   - arrays and structs append their elements and fields in order, skipping blank fields, which == ignores
+//   - interfaces append their dynamic type and value, or nothing but a marker if they are nil
+//
+// Panics if the value holds an interface whose dynamic type is not comparable, just like == would.
+func appendKey(buf []byte, v reflect.Value) []byte {
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.LittleEndian.AppendUint64(buf, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		// Adding zero turns -0 into +0 and leaves every other value as it is.
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(v.Float()+0))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(real(c)+0))
+		return binary.LittleEndian.AppendUint64(buf, math.Float64bits(imag(c)+0))
+	case reflect.String:
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(v.Len()))
+		return append(buf, v.String()...)
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return binary.LittleEndian.AppendUint64(buf, uint64(v.Pointer()))
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			buf = appendKey(buf, v.Index(i))
+		}
+		return buf
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).Name != "_" {
+				buf = appendKey(buf, v.Field(i))
+			}
+		}
+		return buf
+	case reflect.Interface:
+		if v.IsNil() {
+			return append(buf, 0)
+		}
+		buf = append(buf, 1)
+		buf = append(buf, v.Elem().Type().String()...)
+		return appendKey(buf, v.Elem())
+	default:
+		panic(fmt.Sprintf("cln: can't hash key of incomparable type %s", v.Type()))
+	}
+}
+
+// Returns the given value with its bits thoroughly mixed (the splitmix64 finalizer), so that sequential integers land in
+// different shards.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,380 @@
+package cln
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// A copyOnWriteList is a Collection for data that is read far more often than it is changed, and that is shared between
+// goroutines. Its elements live in an immutable slice behind an atomic pointer: reads and iteration load the current
+// slice without taking any lock, while every write copies the slice, changes the copy and swaps it in. Writers are
+// serialized by a mutex, so concurrent writes never lose each other's changes.
+//
+// Elements are kept in insertion order; Take removes the oldest element. Iter, and every other read, sees the snapshot
+// current when it started and is unaffected by later writes. Writes are O(n), so the list suits small collections that
+// change rarely, such as configuration.
+type copyOnWriteList[T comparable] struct {
+	mu   sync.Mutex
+	vals atomic.Pointer[[]T]
+}
+
+// Returns a new instance of a copy-on-write list of the specified type.
+func NewCopyOnWriteList[T comparable]() *copyOnWriteList[T] {
+	return &copyOnWriteList[T]{}
+}
+
+// Returns a new copy-on-write list holding the given elements, in order.
+func CopyOnWriteListOf[T comparable](vals ...T) *copyOnWriteList[T] {
+	l := NewCopyOnWriteList[T]()
+	l.Add(vals...)
+	return l
+}
+
+// Returns the current snapshot of the list's elements, which must not be modified.
+func (l *copyOnWriteList[T]) snapshot() []T {
+	if p := l.vals.Load(); p != nil {
+		return *p
+	}
+
+	return nil
+}
+
+// Replaces the list's elements with the given slice, which must not be modified afterwards.
+func (l *copyOnWriteList[T]) publish(vals []T) {
+	l.vals.Store(&vals)
+}
+
+// Adds element(s) to the end of the list.
+func (l *copyOnWriteList[T]) Add(vals ...T) {
+	if len(vals) == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if debug {
+		defer mustValidate(l)
+	}
+
+	cur := l.snapshot()
+	next := make([]T, len(cur)+len(vals))
+	copy(next, cur)
+	copy(next[len(cur):], vals)
+	l.publish(next)
+}
+
+// Adds all elements of the given collection, in the other collection's iteration order, to the end of the list.
+func (l *copyOnWriteList[T]) AddAll(other Collection[T]) {
+	l.Add(values(other)...)
+}
+
+// Removes the first element of the list and returns it along with a bool value of true if the list is not empty,
+// otherwise, it will return the zero value of the list's type and a bool value of false.
+func (l *copyOnWriteList[T]) Take() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if debug {
+		defer mustValidate(l)
+	}
+
+	cur := l.snapshot()
+	if len(cur) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	next := make([]T, len(cur)-1)
+	copy(next, cur[1:])
+	l.publish(next)
+	return cur[0], true
+}
+
+// Removes all elements from the list.
+func (l *copyOnWriteList[T]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if debug {
+		defer mustValidate(l)
+	}
+
+	l.publish(nil)
+}
+
+// Returns true if the list contains the given element, returns false otherwise.
+func (l *copyOnWriteList[T]) Contains(val T) bool {
+	return l.IndexOf(val) != -1
+}
+
+// Returns true if the list contains every one of the given elements, returns false otherwise.
+func (l *copyOnWriteList[T]) ContainsAll(vals ...T) bool {
+	return containsAll[T](l, vals)
+}
+
+// Returns true if the list contains at least one of the given elements, returns false otherwise.
+func (l *copyOnWriteList[T]) ContainsAny(vals ...T) bool {
+	return containsAny[T](l, vals)
+}
+
+// Returns the index of the first instance of the given element in the list. Returns -1 if the list does not contain
+// the element.
+func (l *copyOnWriteList[T]) IndexOf(val T) int {
+	for i, v := range l.snapshot() {
+		if v == val {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Returns the index of the last instance of the given element in the list. Returns -1 if the list does not contain the
+// element.
+func (l *copyOnWriteList[T]) LastIndexOf(val T) int {
+	vals := l.snapshot()
+	for i := len(vals) - 1; i >= 0; i-- {
+		if vals[i] == val {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Removes the first instance of the given element from the list. Returns true if an element was removed, returns false
+// otherwise.
+func (l *copyOnWriteList[T]) Remove(val T) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if debug {
+		defer mustValidate(l)
+	}
+
+	i := l.IndexOf(val)
+	if i == -1 {
+		return false
+	}
+
+	cur := l.snapshot()
+	next := make([]T, 0, len(cur)-1)
+	next = append(next, cur[:i]...)
+	next = append(next, cur[i+1:]...)
+	l.publish(next)
+	return true
+}
+
+// Removes every instance of each of the given elements from the list and returns the amount of elements removed.
+func (l *copyOnWriteList[T]) RemoveAll(vals ...T) int {
+	if len(vals) == 0 {
+		return 0
+	}
+
+	return l.RemoveIf(memberOf(vals))
+}
+
+// Removes every instance of the given element from the list and returns the amount of elements removed.
+func (l *copyOnWriteList[T]) RemoveEvery(val T) int {
+	return l.RemoveIf(func(v T) bool {
+		return v == val
+	})
+}
+
+// Removes all elements from the list that are not contained in the given collection and returns the amount of
+// elements removed.
+func (l *copyOnWriteList[T]) RetainAll(other Collection[T]) int {
+	return l.RetainIf(memberOf(values(other)))
+}
+
+// Filters all elements from the list that satisfy the given predicate.
+//
+// Deprecated: Filter removes the elements that satisfy the predicate, which is the opposite of what most expect from
+// a filter. Use RemoveIf, RetainIf or Where instead.
+func (l *copyOnWriteList[T]) Filter(filter func(val T) bool) {
+	l.RemoveIf(filter)
+}
+
+// Removes all elements from the list that satisfy the given predicate and returns the amount of elements removed. The
+// list is only copied if an element is removed.
+func (l *copyOnWriteList[T]) RemoveIf(pred func(val T) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if debug {
+		defer mustValidate(l)
+	}
+
+	cur := l.snapshot()
+	var next []T
+	for _, v := range cur {
+		if !pred(v) {
+			next = append(next, v)
+		}
+	}
+
+	removed := len(cur) - len(next)
+	if removed > 0 {
+		l.publish(next)
+	}
+
+	return removed
+}
+
+// Removes all elements from the list that do not satisfy the given predicate and returns the amount of elements removed.
+func (l *copyOnWriteList[T]) RetainIf(pred func(val T) bool) int {
+	return l.RemoveIf(func(val T) bool {
+		return !pred(val)
+	})
+}
+
+// Returns a new copy-on-write list containing, in order, the elements of the list that satisfy the given predicate.
+// The list itself is left unchanged.
+func (l *copyOnWriteList[T]) Where(pred func(val T) bool) Collection[T] {
+	var matches []T
+	for _, v := range l.snapshot() {
+		if pred(v) {
+			matches = append(matches, v)
+		}
+	}
+
+	where := NewCopyOnWriteList[T]()
+	where.publish(matches)
+	return where
+}
+
+// Returns the amount of elements contained within the list.
+func (l *copyOnWriteList[T]) Size() int {
+	return len(l.snapshot())
+}
+
+// Returns true if the list contains no elements, otherwise returns false.
+func (l *copyOnWriteList[T]) IsEmpty() bool {
+	return len(l.snapshot()) == 0
+}
+
+// Returns an error describing the first broken invariant of the list, or nil if the list is consistent. Published
+// snapshots are never modified, so the only invariant is that the snapshot's spare capacity is unused.
+func (l *copyOnWriteList[T]) Validate() error {
+	vals := l.snapshot()
+
+	var zero T
+	for i, v := range vals[len(vals):cap(vals)] {
+		if v != zero {
+			return fmt.Errorf("copy-on-write list: spare slot %d holds %v", len(vals)+i, v)
+		}
+	}
+
+	return nil
+}
+
+// Returns the structure of the list: every slot of its current snapshot, in order.
+func (l *copyOnWriteList[T]) Structure() Structure {
+	vals := l.snapshot()
+	return arrayStructure("copyOnWriteList", fmt.Sprintf("snapshot (len %d)", len(vals)), vals)
+}
+
+// Returns a string representation of the list.
+func (l *copyOnWriteList[T]) String() string {
+	return fmt.Sprint(l.snapshot())
+}
+
+// Formats the current snapshot of the list for the fmt package. %v prints its elements in order, %+v also prints its
+// size, first and last elements, and %#v prints a call to CopyOnWriteListOf that rebuilds it. Long lists are
+// truncated; see FormatLimit.
+func (l *copyOnWriteList[T]) Format(f fmt.State, verb rune) {
+	vals := l.snapshot()
+	formatCollection(f, verb, layout{"copyOnWriteList", "first", "last", "cln.CopyOnWriteListOf"}, len(vals),
+		func(yield func(val T) bool) {
+			for _, v := range vals {
+				if !yield(v) {
+					return
+				}
+			}
+		})
+}
+
+// Returns the list encoded as a JSON array of its elements, in order.
+func (l *copyOnWriteList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(values[T](l))
+}
+
+// Replaces the elements of the list with those of the given JSON array, restoring the order produced by MarshalJSON.
+// Readers see either the old elements or the new ones, never a mix.
+func (l *copyOnWriteList[T]) UnmarshalJSON(data []byte) error {
+	var vals []T
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.publish(vals)
+	return nil
+}
+
+// Writes the binary encoding of the list to w, one element at a time, in order.
+func (l *copyOnWriteList[T]) EncodeTo(w io.Writer) error {
+	vals := l.snapshot()
+	return encodeCollection(w, len(vals), func(yield func(val T) bool) {
+		for _, v := range vals {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Replaces the elements of the list with those read from r, which must hold an encoding written by EncodeTo. On error
+// the list is left unchanged.
+func (l *copyOnWriteList[T]) DecodeFrom(r io.Reader) error {
+	var vals []T
+	if err := decodeCollection(r, func(val T) {
+		vals = append(vals, val)
+	}); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.publish(vals)
+	return nil
+}
+
+// Returns the binary encoding of the list, as written by EncodeTo.
+func (l *copyOnWriteList[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := l.EncodeTo(&buf)
+	return buf.Bytes(), err
+}
+
+// Replaces the elements of the list with those of the given binary encoding.
+func (l *copyOnWriteList[T]) UnmarshalBinary(data []byte) error {
+	return l.DecodeFrom(bytes.NewReader(data))
+}
+
+// Returns the binary encoding of the list, for use by encoding/gob.
+func (l *copyOnWriteList[T]) GobEncode() ([]byte, error) {
+	return l.MarshalBinary()
+}
+
+// Replaces the elements of the list with those of the given gob encoding.
+func (l *copyOnWriteList[T]) GobDecode(data []byte) error {
+	return l.UnmarshalBinary(data)
+}
+
+// Returns a chan of the same type of the collection, fed from the snapshot current when Iter is called.
+func (l *copyOnWriteList[T]) Iter() chan T {
+	vals := l.snapshot()
+
+	c := make(chan T)
+	go func() {
+		for _, v := range vals {
+			c <- v
+		}
+		close(c)
+	}()
+	return c
+}
@@ -0,0 +1,111 @@
+package cln
+
+import (
+	"fmt"
+	"math"
+)
+
+// A countMinSketch counts how often each element occurs in a stream, in memory that depends on the accuracy wanted
+// rather than on the amount of distinct elements. It is a grid of counters, depth rows of width columns; every row
+// hashes an element to one of its counters, and the smallest of the element's counters is its estimate.
+//
+// Estimates never fall below the true count. With a width of e/epsilon and a depth of ln(1/delta), an estimate exceeds
+// the true count by more than epsilon times the total of all counts with a probability of at most delta. Increments use
+// conservative update, raising only the counters that are below the element's new estimate, which keeps estimates of
+// rare elements much closer to the truth than raising every counter would.
+//
+// A count-min sketch is not safe for concurrent use.
+type countMinSketch[T comparable] struct {
+	counters []uint64 // depth rows of width counters
+	width    uint64
+	depth    int
+	total    uint64
+	hash     func(val T) uint64
+}
+
+// Returns a new, empty count-min sketch whose estimates exceed the true counts by at most epsilon times the total of
+// all counts, with a probability of at least 1-delta. For example, an epsilon of 0.001 and a delta of 0.01 take 2719
+// counters in each of 5 rows. Elements are hashed by stableHash.
+func NewCountMinSketch[T comparable](epsilon, delta float64) *countMinSketch[T] {
+	return NewCountMinSketchWithHasher(epsilon, delta, stableHash[T])
+}
+
+// Returns a new, empty count-min sketch for the given accuracy, using the given hash function in place of stableHash.
+func NewCountMinSketchWithHasher[T comparable](epsilon, delta float64, hash func(val T) uint64) *countMinSketch[T] {
+	if epsilon <= 0 || epsilon >= 1 || delta <= 0 || delta >= 1 {
+		panic(fmt.Sprintf("cln: count-min sketch epsilon %v and delta %v must be between 0 and 1", epsilon, delta))
+	}
+
+	width := uint64(math.Ceil(math.E / epsilon))
+	depth := int(math.Ceil(math.Log(1 / delta)))
+	return &countMinSketch[T]{counters: make([]uint64, width*uint64(depth)), width: width, depth: depth, hash: hash}
+}
+
+// Calls f with the index of the given element's counter in each row.
+func (s *countMinSketch[T]) each(val T, f func(i uint64)) {
+	h1 := s.hash(val)
+	h2 := mix64(h1) | 1
+	for row := 0; row < s.depth; row++ {
+		f(uint64(row)*s.width + (h1+uint64(row)*h2)%s.width)
+	}
+}
+
+// Counts one more occurrence of the given element and returns its new estimated count.
+func (s *countMinSketch[T]) Increment(val T) uint64 {
+	return s.IncrementBy(val, 1)
+}
+
+// Counts the given amount of occurrences of the given element and returns its new estimated count.
+func (s *countMinSketch[T]) IncrementBy(val T, count uint64) uint64 {
+	estimate := s.Estimate(val) + count
+	s.each(val, func(i uint64) {
+		if s.counters[i] < estimate {
+			s.counters[i] = estimate
+		}
+	})
+	s.total += count
+
+	return estimate
+}
+
+// Returns the estimated amount of occurrences of the given element, which is never less than the true amount.
+func (s *countMinSketch[T]) Estimate(val T) uint64 {
+	estimate := uint64(math.MaxUint64)
+	s.each(val, func(i uint64) {
+		if s.counters[i] < estimate {
+			estimate = s.counters[i]
+		}
+	})
+
+	return estimate
+}
+
+// Returns the total of all counts.
+func (s *countMinSketch[T]) Total() uint64 {
+	return s.total
+}
+
+// Adds the counts of the other sketch to the sketch, so that it estimates the counts of both streams together. Returns
+// an error, leaving the sketch unchanged, if the sketches differ in width or depth. Both sketches must use the same
+// hash function.
+func (s *countMinSketch[T]) Merge(other *countMinSketch[T]) error {
+	if s.width != other.width || s.depth != other.depth {
+		return fmt.Errorf("cln: can't merge a count-min sketch of %dx%d counters with one of %dx%d counters", s.depth,
+			s.width, other.depth, other.width)
+	}
+
+	for i, c := range other.counters {
+		s.counters[i] += c
+	}
+	s.total += other.total
+
+	return nil
+}
+
+// Resets every count to zero.
+func (s *countMinSketch[T]) Clear() {
+	for i := range s.counters {
+		s.counters[i] = 0
+	}
+	s.total = 0
+}
@@ -0,0 +1,262 @@
+package cln
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrFilterFull is returned by a cuckoo filter's Add when no room can be made for an element.
+var ErrFilterFull = errors.New("cln: cuckoo filter is full")
+
+// The binary encoding of a cuckoo filter starts with cuckooMagic followed by a version byte. Version 1 continues with
+// the amount of fingerprints stored and the amount of buckets, both as uint64s, followed by every fingerprint slot as a
+// uint16, all little endian.
+const (
+	cuckooMagic   = "clnC"
+	cuckooVersion = 1
+)
+
+const (
+	// The amount of fingerprints each bucket of a cuckoo filter holds.
+	bucketSize = 4
+	// The most fingerprints Add relocates while making room for an element before giving up.
+	maxKicks = 500
+	// The load factor a cuckoo filter is sized for; buckets of four fingerprints reliably fill up to about 95%.
+	cuckooLoad = 0.95
+)
+
+// A cuckooFilter is a probabilistic set that, unlike a bloom filter, supports deleting elements. It stores a 16 bit
+// fingerprint of each element in one of two buckets of four slots: the element's hash picks the first bucket, and the
+// second is the first XORed with the hash of the fingerprint, so that either bucket can be found from the other and
+// the fingerprint alone. When both buckets are full, Add evicts a fingerprint to its alternate bucket, and so on,
+// until every fingerprint has a place.
+//
+// The false-positive rate is about 0.01% and doesn't depend on the size of the filter. Once the filter is close to
+// full, Add fails with ErrFilterFull rather than degrading; elements already added stay in the filter.
+//
+// An element may only be deleted if it was added, otherwise the fingerprint of another element that happens to match it
+// may be deleted instead. Adding an element twice stores it twice, and it must then be deleted twice. A cuckoo filter is
+// not safe for concurrent use.
+type cuckooFilter[T comparable] struct {
+	slots []uint16 // bucketSize slots per bucket; 0 marks an empty slot
+	mask  uint64   // the amount of buckets, a power of two, minus one
+	count int
+	hash  func(val T) uint64
+	rand  uint64 // state of the generator picking which fingerprint to evict
+}
+
+// Returns a new, empty cuckoo filter with room for at least the given amount of elements, hashed by stableHash.
+func NewCuckooFilter[T comparable](capacity int) *cuckooFilter[T] {
+	return NewCuckooFilterWithHasher(capacity, stableHash[T])
+}
+
+// Returns a new, empty cuckoo filter with room for at least the given amount of elements, hashing them with the given
+// function rather than stableHash.
+func NewCuckooFilterWithHasher[T comparable](capacity int, hash func(val T) uint64) *cuckooFilter[T] {
+	buckets := uint64(1)
+	for float64(buckets*bucketSize)*cuckooLoad < float64(capacity) {
+		buckets <<= 1
+	}
+
+	return &cuckooFilter[T]{slots: make([]uint16, buckets*bucketSize), mask: buckets - 1, hash: hash, rand: 1}
+}
+
+// Returns the fingerprint and first bucket of the given element.
+func (cf *cuckooFilter[T]) locate(val T) (uint16, uint64) {
+	h := cf.hash(val)
+	fp := uint16(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+
+	return fp, h & cf.mask
+}
+
+// Returns the bucket that, along with the given one, may hold the given fingerprint.
+func (cf *cuckooFilter[T]) alternate(bucket uint64, fp uint16) uint64 {
+	return (bucket ^ mix64(uint64(fp))) & cf.mask
+}
+
+// Stores the fingerprint in an empty slot of the given bucket and returns true, or returns false if the bucket is full.
+func (cf *cuckooFilter[T]) insert(bucket uint64, fp uint16) bool {
+	for i := bucket * bucketSize; i < (bucket+1)*bucketSize; i++ {
+		if cf.slots[i] == 0 {
+			cf.slots[i] = fp
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the index of a slot of the given bucket holding the fingerprint, or -1 if there is none.
+func (cf *cuckooFilter[T]) find(bucket uint64, fp uint16) int {
+	for i := bucket * bucketSize; i < (bucket+1)*bucketSize; i++ {
+		if cf.slots[i] == fp {
+			return int(i)
+		}
+	}
+
+	return -1
+}
+
+// Adds element(s) to the filter. If no room can be made for an element, returns ErrFilterFull, leaving the filter as it
+// was before that element was added; the elements before it remain added.
+func (cf *cuckooFilter[T]) Add(vals ...T) error {
+	for _, val := range vals {
+		if err := cf.add(val); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Adds a single element to the filter, relocating fingerprints to their alternate buckets if both of the element's
+// buckets are full. If relocating fails, every relocation is undone.
+func (cf *cuckooFilter[T]) add(val T) error {
+	fp, b1 := cf.locate(val)
+	b2 := cf.alternate(b1, fp)
+	if cf.insert(b1, fp) || cf.insert(b2, fp) {
+		cf.count++
+		return nil
+	}
+
+	// Evict a random fingerprint from one of the buckets and carry it to its alternate bucket, and so on.
+	var evicted []int
+	bucket := b1
+	if cf.next()&1 == 1 {
+		bucket = b2
+	}
+	for kick := 0; kick < maxKicks; kick++ {
+		slot := int(bucket*bucketSize + cf.next()%bucketSize)
+		fp, cf.slots[slot] = cf.slots[slot], fp
+		evicted = append(evicted, slot)
+
+		bucket = cf.alternate(bucket, fp)
+		if cf.insert(bucket, fp) {
+			cf.count++
+			return nil
+		}
+	}
+
+	// Put every evicted fingerprint back where it was, in reverse, which leaves the element's own fingerprint in hand.
+	for i := len(evicted) - 1; i >= 0; i-- {
+		fp, cf.slots[evicted[i]] = cf.slots[evicted[i]], fp
+	}
+
+	return ErrFilterFull
+}
+
+// Returns the next value of the xorshift generator picking fingerprints to evict.
+func (cf *cuckooFilter[T]) next() uint64 {
+	cf.rand ^= cf.rand << 13
+	cf.rand ^= cf.rand >> 7
+	cf.rand ^= cf.rand << 17
+	return cf.rand
+}
+
+// Returns false if the given element was definitely never added to the filter, or has since been deleted, and true if
+// it might be in the filter.
+func (cf *cuckooFilter[T]) Contains(val T) bool {
+	fp, b1 := cf.locate(val)
+	return cf.find(b1, fp) != -1 || cf.find(cf.alternate(b1, fp), fp) != -1
+}
+
+// Returns the same as Contains.
+func (cf *cuckooFilter[T]) MightContain(val T) bool {
+	return cf.Contains(val)
+}
+
+// Deletes one instance of the given element from the filter. Returns true if a matching fingerprint was deleted,
+// returns false otherwise. The element must have been added; see cuckooFilter.
+func (cf *cuckooFilter[T]) Delete(val T) bool {
+	fp, b1 := cf.locate(val)
+
+	i := cf.find(b1, fp)
+	if i == -1 {
+		i = cf.find(cf.alternate(b1, fp), fp)
+	}
+	if i == -1 {
+		return false
+	}
+
+	cf.slots[i] = 0
+	cf.count--
+	return true
+}
+
+// Returns the amount of elements in the filter. Unlike a bloom filter's estimate, the count is exact, but an element
+// added twice is counted twice.
+func (cf *cuckooFilter[T]) EstimatedCount() int {
+	return cf.count
+}
+
+// Returns the fraction of the filter's slots in use. Add starts failing at a load factor of about 0.95.
+func (cf *cuckooFilter[T]) LoadFactor() float64 {
+	return float64(cf.count) / float64(len(cf.slots))
+}
+
+// Returns the amount of fingerprint slots in the filter.
+func (cf *cuckooFilter[T]) Capacity() int {
+	return len(cf.slots)
+}
+
+// Removes all elements from the filter.
+func (cf *cuckooFilter[T]) Clear() {
+	for i := range cf.slots {
+		cf.slots[i] = 0
+	}
+	cf.count = 0
+}
+
+// Returns the binary encoding of the filter.
+func (cf *cuckooFilter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(cuckooMagic)+1+16+2*len(cf.slots))
+	data = append(data, cuckooMagic...)
+	data = append(data, cuckooVersion)
+	data = binary.LittleEndian.AppendUint64(data, uint64(cf.count))
+	data = binary.LittleEndian.AppendUint64(data, cf.mask+1)
+	for _, fp := range cf.slots {
+		data = binary.LittleEndian.AppendUint16(data, fp)
+	}
+
+	return data, nil
+}
+
+// Replaces the filter with the one held by the given binary encoding, taking on its size. The filter keeps its hash
+// function, or uses the default one if it is the zero value.
+func (cf *cuckooFilter[T]) UnmarshalBinary(data []byte) error {
+	header := len(cuckooMagic) + 1 + 16
+	if len(data) < header || string(data[:len(cuckooMagic)]) != cuckooMagic {
+		return errors.New("cln: data is not an encoded cuckoo filter")
+	}
+	if version := data[len(cuckooMagic)]; version != cuckooVersion {
+		return fmt.Errorf("cln: unsupported cuckoo filter encoding version %d", version)
+	}
+
+	count := binary.LittleEndian.Uint64(data[len(cuckooMagic)+1:])
+	buckets := binary.LittleEndian.Uint64(data[len(cuckooMagic)+9:])
+	slots := data[header:]
+	// Compare the counts against the size of the data rather than multiplying them, which could overflow.
+	if buckets == 0 || buckets&(buckets-1) != 0 || len(slots)%(bucketSize*2) != 0 ||
+		buckets != uint64(len(slots))/(bucketSize*2) || count > uint64(len(slots))/2 {
+		return fmt.Errorf("cln: cuckoo filter of %d buckets holding %d elements can't be held by %d bytes", buckets,
+			count, len(slots))
+	}
+
+	cf.slots = make([]uint16, buckets*bucketSize)
+	for i := range cf.slots {
+		cf.slots[i] = binary.LittleEndian.Uint16(slots[2*i:])
+	}
+	cf.mask, cf.count = buckets-1, int(count)
+	if cf.hash == nil {
+		cf.hash = stableHash[T]
+	}
+	if cf.rand == 0 {
+		cf.rand = 1
+	}
+
+	return nil
+}
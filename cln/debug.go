@@ -0,0 +1,7 @@
+//go:build cln_debug
+
+package cln
+
+// Builds with the cln_debug tag validate a collection's invariants after every mutation and panic on the first
+// violation.
+const debug = true
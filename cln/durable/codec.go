@@ -0,0 +1,61 @@
+package durable
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// A Codec converts the elements of a durable queue to and from the bytes stored in its log. Decode must accept anything
+// Encode returns, including the output of earlier runs of the program, so changing an element type's encoding calls for
+// a new directory or a codec that understands both.
+type Codec[T any] interface {
+	Encode(val T) ([]byte, error)
+	Decode(data []byte) (T, error)
+}
+
+// A gobCodec is a Codec that encodes every element as a standalone gob stream.
+type gobCodec[T any] struct{}
+
+// Returns a Codec that encodes elements with encoding/gob. Every record carries its own type information, so it suits
+// any gob encodable type at the cost of a few dozen bytes per element.
+func GobCodec[T any]() Codec[T] {
+	return gobCodec[T]{}
+}
+
+// Returns the gob encoding of the given element.
+func (gobCodec[T]) Encode(val T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(val); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Returns the element held by the given gob encoding.
+func (gobCodec[T]) Decode(data []byte) (T, error) {
+	var val T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&val)
+	return val, err
+}
+
+// A jsonCodec is a Codec that encodes every element as JSON.
+type jsonCodec[T any] struct{}
+
+// Returns a Codec that encodes elements with encoding/json, which keeps the log readable with standard tools.
+func JSONCodec[T any]() Codec[T] {
+	return jsonCodec[T]{}
+}
+
+// Returns the JSON encoding of the given element.
+func (jsonCodec[T]) Encode(val T) ([]byte, error) {
+	return json.Marshal(val)
+}
+
+// Returns the element held by the given JSON encoding.
+func (jsonCodec[T]) Decode(data []byte) (T, error) {
+	var val T
+	err := json.Unmarshal(data, &val)
+	return val, err
+}
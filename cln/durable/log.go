@@ -0,0 +1,207 @@
+package durable
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The log of a durable queue is a directory of segment files and a checkpoint file.
+//
+// A segment is named after the sequence number of its first element, zero padded to 20 digits so that names sort in
+// log order, and holds consecutive records. A record is an 8 byte header - the payload's length and its CRC-32C, both
+// little endian uint32s - followed by the payload, the element as encoded by the queue's Codec.
+//
+// The checkpoint file holds the sequence number of the oldest element not yet taken, followed by its CRC-32C. It is
+// replaced atomically by writing a temporary file and renaming it over the old one.
+const (
+	segmentExt       = ".seg"
+	checkpointFile   = "checkpoint"
+	recordHeaderSize = 8
+	maxRecordSize    = 1 << 30
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// errTornRecord is returned when reading a record that was only partly written or whose checksum does not match.
+var errTornRecord = errors.New("durable: torn or corrupt record")
+
+// Returns the file name of the segment whose first element has the given sequence number.
+func segmentName(first uint64) string {
+	return fmt.Sprintf("%020d%s", first, segmentExt)
+}
+
+// Returns the sequence numbers of the first elements of the segments in dir, in ascending order.
+func listSegments(dir string) ([]uint64, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []uint64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+
+		first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("durable: unexpected segment file %q", name)
+		}
+		segments = append(segments, first)
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i] < segments[j]
+	})
+	return segments, nil
+}
+
+// Writes a record holding the given payload to w with a single call to Write.
+func writeRecord(w io.Writer, payload []byte) (int64, error) {
+	if len(payload) > maxRecordSize {
+		return 0, fmt.Errorf("durable: encoded element of %d bytes exceeds the limit of %d", len(payload), maxRecordSize)
+	}
+
+	n, err := w.Write(appendRecord(make([]byte, 0, recordHeaderSize+len(payload)), payload))
+	return int64(n), err
+}
+
+// Appends a record holding the given payload to buf and returns the extended buffer.
+func appendRecord(buf []byte, payload []byte) []byte {
+	var header [recordHeaderSize]byte
+	binary.LittleEndian.PutUint32(header[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(header[4:8], crc32.Checksum(payload, crcTable))
+
+	buf = append(buf, header[:]...)
+	return append(buf, payload...)
+}
+
+// Reads the next record from r and returns its payload. Returns io.EOF if r is exhausted exactly at a record boundary,
+// and errTornRecord if the record is incomplete or damaged.
+func readRecord(r io.Reader) ([]byte, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errTornRecord
+		}
+		return nil, err
+	}
+
+	size := binary.LittleEndian.Uint32(header[0:4])
+	if size > maxRecordSize {
+		return nil, errTornRecord
+	}
+
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errTornRecord
+		}
+		return nil, err
+	}
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+		return nil, errTornRecord
+	}
+
+	return payload, nil
+}
+
+// Reads every record of the segment at the given path and returns the amount of intact records along with the size of
+// the prefix of the file they occupy. Returns errTornRecord, along with the intact prefix, if the file ends with an
+// incomplete or damaged record.
+func scanSegment(path string) (int, int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	count, valid := 0, int64(0)
+	for {
+		payload, err := readRecord(r)
+		if err == io.EOF {
+			return count, valid, nil
+		}
+		if err != nil {
+			return count, valid, err
+		}
+
+		count++
+		valid += recordHeaderSize + int64(len(payload))
+	}
+}
+
+// Returns the sequence number stored in the checkpoint file of dir, or 0 if there is no checkpoint yet.
+func readCheckpoint(dir string) (uint64, error) {
+	data, err := os.ReadFile(filepath.Join(dir, checkpointFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data) != 12 || crc32.Checksum(data[:8], crcTable) != binary.LittleEndian.Uint32(data[8:]) {
+		return 0, errors.New("durable: checkpoint file is corrupt")
+	}
+
+	return binary.LittleEndian.Uint64(data[:8]), nil
+}
+
+// Atomically replaces the checkpoint file of dir with one holding the given sequence number. If sync is true, the new
+// checkpoint is flushed to stable storage before returning.
+func writeCheckpoint(dir string, seq uint64, sync bool) error {
+	var data [12]byte
+	binary.LittleEndian.PutUint64(data[:8], seq)
+	binary.LittleEndian.PutUint32(data[8:], crc32.Checksum(data[:8], crcTable))
+
+	tmp := filepath.Join(dir, checkpointFile+".tmp")
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data[:]); err != nil {
+		f.Close()
+		return err
+	}
+	if sync {
+		if err := f.Sync(); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, filepath.Join(dir, checkpointFile)); err != nil {
+		return err
+	}
+	if sync {
+		return syncDir(dir)
+	}
+
+	return nil
+}
+
+// Flushes the directory entries of dir, such as newly created or renamed files, to stable storage.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
@@ -0,0 +1,400 @@
+// Package durable provides FIFO queues backed by the local filesystem. Open returns a persistent queue that survives
+// crashes and restarts: elements are appended to a write-ahead log of segment files, and the position of the consumer
+// is checkpointed next to them, so that reopening the directory recovers exactly the elements that were not yet taken.
+// NewSpillQueue returns a queue that bounds its memory use by spilling elements to temporary files.
+package durable
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// ErrClosed is returned by the methods of a queue that has been closed.
+var ErrClosed = errors.New("durable: queue is closed")
+
+// The size, in bytes, a segment may reach before a new one is started when Options.SegmentSize is not set.
+const defaultSegmentSize = 4 << 20
+
+// Options configures a durable queue. The zero value selects the defaults.
+type Options struct {
+	// The size, in bytes, a segment may reach before the queue starts a new one. Segments are only deleted once every
+	// element in them has been taken, so smaller segments free disk space sooner at the cost of more files. Defaults to
+	// 4 MiB.
+	SegmentSize int64
+
+	// The amount of elements taken between automatic checkpoints. Defaults to 1, which checkpoints after every Take so
+	// that a restart recovers exactly the elements not yet taken. Larger values write less, but a crash redelivers up
+	// to CheckpointEvery-1 elements that were already taken.
+	CheckpointEvery int
+
+	// If true, writes are not flushed to stable storage before returning. Elements then survive the process crashing
+	// but may be lost if the machine does.
+	NoSync bool
+}
+
+// A queue is a FIFO queue whose elements are persisted to a write-ahead log before Add returns. Elements are appended
+// to the newest segment of the log, and taken by reading the log from the checkpointed position onwards. Segments whose
+// elements have all been taken are deleted whenever a checkpoint is written.
+//
+// A queue is safe for concurrent use, but a directory must not be opened by more than one queue at a time.
+type queue[T any] struct {
+	mu    sync.Mutex
+	dir   string
+	codec Codec[T]
+	opts  Options
+
+	segments []uint64 // sequence numbers of the first element of each segment, in ascending order
+	w        *os.File // the newest segment, open for appending
+	wSize    int64
+
+	r     *bufio.Reader // reads the segment at index rSeg, positioned at the element head
+	rFile *os.File
+	rSeg  int
+
+	head         uint64 // sequence number of the oldest element not yet taken
+	tail         uint64 // sequence number the next added element will get
+	checkpointed uint64 // sequence number stored in the checkpoint file
+	closed       bool
+}
+
+// Opens the durable queue stored in the given directory, creating the directory if it does not exist, and returns it
+// holding every element that was added but not taken before it was last closed or the program crashed. A record left
+// incomplete by a crash at the end of the log is discarded.
+func Open[T any](dir string, codec Codec[T], opts Options) (*queue[T], error) {
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = defaultSegmentSize
+	}
+	if opts.CheckpointEvery <= 0 {
+		opts.CheckpointEvery = 1
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	q := &queue[T]{dir: dir, codec: codec, opts: opts}
+	if err := q.recover(); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// Restores the state of the queue from its directory and opens the newest segment for appending.
+func (q *queue[T]) recover() error {
+	checkpoint, err := readCheckpoint(q.dir)
+	if err != nil {
+		return err
+	}
+	segments, err := listSegments(q.dir)
+	if err != nil {
+		return err
+	}
+
+	q.head, q.tail, q.checkpointed = checkpoint, checkpoint, checkpoint
+	for i, first := range segments {
+		if i > 0 && first != q.tail {
+			return fmt.Errorf("durable: segment %s does not follow element %d", segmentName(first), q.tail)
+		}
+
+		path := q.segmentPath(first)
+		count, valid, err := scanSegment(path)
+		if err == errTornRecord && i == len(segments)-1 {
+			// The program crashed while appending; the element was never acknowledged.
+			err = os.Truncate(path, valid)
+		}
+		if err != nil {
+			return fmt.Errorf("durable: recovering segment %s: %w", segmentName(first), err)
+		}
+
+		q.tail = first + uint64(count)
+		q.wSize = valid
+	}
+
+	if len(segments) > 0 && (checkpoint < segments[0] || checkpoint > q.tail) {
+		return fmt.Errorf("durable: checkpoint %d is outside of the log, which holds elements %d to %d", checkpoint,
+			segments[0], q.tail)
+	}
+
+	if len(segments) == 0 {
+		return q.startSegment()
+	}
+
+	q.segments = segments
+	q.w, err = os.OpenFile(q.segmentPath(segments[len(segments)-1]), os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	return q.compact()
+}
+
+// Returns the path of the segment whose first element has the given sequence number.
+func (q *queue[T]) segmentPath(first uint64) string {
+	return filepath.Join(q.dir, segmentName(first))
+}
+
+// Closes the newest segment and starts a new, empty one beginning at the tail of the queue.
+func (q *queue[T]) startSegment() error {
+	if q.w != nil {
+		if err := q.sync(); err != nil {
+			return err
+		}
+		if err := q.w.Close(); err != nil {
+			return err
+		}
+		q.w = nil
+	}
+
+	w, err := os.OpenFile(q.segmentPath(q.tail), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	if !q.opts.NoSync {
+		if err := syncDir(q.dir); err != nil {
+			w.Close()
+			return err
+		}
+	}
+
+	q.w, q.wSize = w, 0
+	q.segments = append(q.segments, q.tail)
+	return nil
+}
+
+// Flushes the newest segment to stable storage unless the queue was opened with NoSync.
+func (q *queue[T]) sync() error {
+	if q.opts.NoSync {
+		return nil
+	}
+
+	return q.w.Sync()
+}
+
+// Adds element(s) to the tail-end of the queue. The elements are written to the log, and flushed to stable storage,
+// before Add returns. If an error is returned, none of the elements that failed to be written were added.
+func (q *queue[T]) Add(vals ...T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	payloads := make([][]byte, len(vals))
+	for i, val := range vals {
+		payload, err := q.codec.Encode(val)
+		if err != nil {
+			return fmt.Errorf("durable: encoding element: %w", err)
+		}
+		payloads[i] = payload
+	}
+
+	for _, payload := range payloads {
+		if q.wSize >= q.opts.SegmentSize {
+			if err := q.startSegment(); err != nil {
+				return err
+			}
+		}
+
+		n, err := writeRecord(q.w, payload)
+		if err != nil {
+			// Drop whatever part of the record made it to the file, so that later records are not appended after it.
+			q.w.Truncate(q.wSize)
+			return err
+		}
+
+		q.wSize += n
+		q.tail++
+	}
+
+	return q.sync()
+}
+
+// Removes the element at the head of the queue and returns it along with true, or returns the zero value and false if
+// the queue is empty. An element that can't be decoded is still removed, and returned as the zero value along with the
+// decoding error.
+//
+// A checkpoint is written after every Options.CheckpointEvery elements taken. If writing it fails, the element is
+// still removed and returned along with the error.
+func (q *queue[T]) Take() (T, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var zero T
+	if q.closed {
+		return zero, false, ErrClosed
+	}
+	if q.head == q.tail {
+		return zero, false, nil
+	}
+
+	payload, err := q.read()
+	if err != nil {
+		return zero, false, err
+	}
+	q.head++
+
+	val, err := q.codec.Decode(payload)
+	if err != nil {
+		return zero, true, fmt.Errorf("durable: decoding element %d: %w", q.head-1, err)
+	}
+
+	if q.head-q.checkpointed >= uint64(q.opts.CheckpointEvery) {
+		return val, true, q.checkpoint()
+	}
+
+	return val, true, nil
+}
+
+// Reads the record of the element at the head of the queue, opening the segment holding it if needed.
+func (q *queue[T]) read() ([]byte, error) {
+	if q.r != nil && q.rSeg+1 < len(q.segments) && q.head == q.segments[q.rSeg+1] {
+		q.closeReader()
+		q.rSeg++
+		if err := q.openReader(q.rSeg, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if q.r == nil {
+		seg := sort.Search(len(q.segments), func(i int) bool {
+			return q.segments[i] > q.head
+		}) - 1
+		if err := q.openReader(seg, q.head-q.segments[seg]); err != nil {
+			return nil, err
+		}
+	}
+
+	payload, err := readRecord(q.r)
+	if err != nil {
+		// Start over from the head on the next attempt.
+		q.closeReader()
+		if err == io.EOF {
+			err = errTornRecord
+		}
+		return nil, fmt.Errorf("durable: reading element %d: %w", q.head, err)
+	}
+
+	return payload, nil
+}
+
+// Opens a reader over the segment at the given index, positioned after its first skip records.
+func (q *queue[T]) openReader(seg int, skip uint64) error {
+	f, err := os.Open(q.segmentPath(q.segments[seg]))
+	if err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	for i := uint64(0); i < skip; i++ {
+		if _, err := readRecord(r); err != nil {
+			f.Close()
+			return fmt.Errorf("durable: seeking to element %d: %w", q.segments[seg]+skip, err)
+		}
+	}
+
+	q.r, q.rFile, q.rSeg = r, f, seg
+	return nil
+}
+
+// Closes the reader, if one is open.
+func (q *queue[T]) closeReader() {
+	if q.rFile != nil {
+		q.rFile.Close()
+	}
+	q.r, q.rFile = nil, nil
+}
+
+// Writes a checkpoint recording every element taken so far, so that they are not recovered when the queue is opened
+// again, and deletes the segments that only hold taken elements.
+func (q *queue[T]) Checkpoint() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	return q.checkpoint()
+}
+
+// Writes a checkpoint at the head of the queue and compacts the log.
+func (q *queue[T]) checkpoint() error {
+	if q.head == q.checkpointed {
+		return nil
+	}
+
+	if err := writeCheckpoint(q.dir, q.head, !q.opts.NoSync); err != nil {
+		return err
+	}
+	q.checkpointed = q.head
+
+	return q.compact()
+}
+
+// Deletes the segments, other than the newest, whose elements all precede the checkpoint. Segments are deleted oldest
+// first, so a crash part way through leaves the remaining segments contiguous.
+func (q *queue[T]) compact() error {
+	n := 0
+	for n+1 < len(q.segments) && q.segments[n+1] <= q.checkpointed {
+		n++
+	}
+	if n == 0 {
+		return nil
+	}
+
+	if q.r != nil && q.rSeg < n {
+		q.closeReader()
+	}
+	for _, first := range q.segments[:n] {
+		if err := os.Remove(q.segmentPath(first)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		q.segments = q.segments[1:]
+		q.rSeg--
+	}
+
+	return nil
+}
+
+// Returns the amount of elements in the queue.
+func (q *queue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return int(q.tail - q.head)
+}
+
+// Returns true if the queue contains no elements, otherwise returns false.
+func (q *queue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Writes a final checkpoint and closes the files of the queue. Closing a closed queue does nothing.
+func (q *queue[T]) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+
+	err := q.checkpoint()
+	if syncErr := q.sync(); err == nil {
+		err = syncErr
+	}
+	if closeErr := q.w.Close(); err == nil {
+		err = closeErr
+	}
+	q.closeReader()
+
+	return err
+}
@@ -0,0 +1,281 @@
+package durable
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ErrDiskBudgetExceeded is returned by Add when spilling the elements of a spill queue to disk would take more than
+// SpillOptions.MaxDiskBytes.
+var ErrDiskBudgetExceeded = errors.New("durable: spill queue exceeded its disk budget")
+
+// The amount of elements a spill queue keeps in memory when SpillOptions.MaxInMemory is not set.
+const defaultMaxInMemory = 1024
+
+// SpillOptions configures a spill queue. The zero value selects the defaults.
+type SpillOptions struct {
+	// The most elements the queue keeps in memory; the rest are spilled to disk. Defaults to 1024, and is raised to 2
+	// if set lower.
+	MaxInMemory int
+
+	// The most bytes the spilled elements may take on disk, or 0 for no limit.
+	MaxDiskBytes int64
+
+	// The directory in which the queue creates its temporary directory. Defaults to os.TempDir.
+	Dir string
+}
+
+// A spillQueue is a FIFO queue for backlogs that may not fit in memory. Elements about to be taken are kept in memory
+// at the head of the queue, and newly added elements are encoded and collected in memory at its tail. Whenever the tail
+// fills up, its records are written to a temporary file - spilled - and whenever the head runs out, it is refilled from
+// the oldest spilled file, which is then deleted, or from the tail if nothing is spilled. At most
+// SpillOptions.MaxInMemory elements are held in memory, however long the queue grows.
+//
+// Spilled elements are only held for the lifetime of the queue; see Open for a queue that survives restarts. A spill
+// queue is safe for concurrent use.
+type spillQueue[T any] struct {
+	mu    sync.Mutex
+	codec Codec[T]
+	opts  SpillOptions
+
+	head    []T // the oldest elements, taken from headPos onwards
+	headPos int
+	tail    []byte // the newest elements, encoded as records, spilled once batch of them are collected
+	tailLen int    // the amount of elements in tail
+	batch   int
+
+	dir       string   // the temporary directory holding spilled files, created on the first spill
+	spilled   []string // paths of the spilled files, oldest first
+	sizes     []int64  // sizes of the spilled files
+	diskBytes int64
+	files     int // the amount of files spilled so far, used to name them
+	size      int
+	closed    bool
+}
+
+// Returns a new, empty spill queue that encodes the elements it spills with the given codec.
+func NewSpillQueue[T any](codec Codec[T], opts SpillOptions) *spillQueue[T] {
+	if opts.MaxInMemory <= 0 {
+		opts.MaxInMemory = defaultMaxInMemory
+	}
+	if opts.MaxInMemory < 2 {
+		opts.MaxInMemory = 2
+	}
+
+	// The head holds up to MaxInMemory - batch elements, which is never less than the batch refilling it.
+	return &spillQueue[T]{codec: codec, opts: opts, batch: opts.MaxInMemory / 2}
+}
+
+// Adds element(s) to the tail-end of the queue, spilling the tail to disk whenever it fills up. Elements are encoded as
+// they are added to the tail, so an element the codec can't encode is rejected right away. If an error is returned, the
+// element being added when it occurred and those following it were not added.
+func (q *spillQueue[T]) Add(vals ...T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return ErrClosed
+	}
+
+	for _, val := range vals {
+		if len(q.spilled) == 0 && len(q.tail) == 0 && len(q.head)-q.headPos < q.opts.MaxInMemory-q.batch {
+			q.compactHead()
+			q.head = append(q.head, val)
+			q.size++
+			continue
+		}
+
+		payload, err := q.codec.Encode(val)
+		if err != nil {
+			return fmt.Errorf("durable: encoding element: %w", err)
+		}
+
+		n := len(q.tail)
+		q.tail = appendRecord(q.tail, payload)
+		q.tailLen++
+		if q.tailLen == q.batch {
+			if err := q.spill(); err != nil {
+				q.tail = q.tail[:n]
+				q.tailLen--
+				return err
+			}
+		}
+		q.size++
+	}
+
+	return nil
+}
+
+// Moves the elements of the head to the front of its slice if the slice is full, so that taken elements don't keep
+// growing it.
+func (q *spillQueue[T]) compactHead() {
+	if q.headPos == 0 || len(q.head) < cap(q.head) {
+		return
+	}
+
+	n := copy(q.head, q.head[q.headPos:])
+	var zero T
+	for i := n; i < len(q.head); i++ {
+		q.head[i] = zero
+	}
+	q.head, q.headPos = q.head[:n], 0
+}
+
+// Writes the records of the tail to a new temporary file and empties the tail.
+func (q *spillQueue[T]) spill() error {
+	size := int64(len(q.tail))
+	if q.opts.MaxDiskBytes > 0 && q.diskBytes+size > q.opts.MaxDiskBytes {
+		return ErrDiskBudgetExceeded
+	}
+
+	if q.dir == "" {
+		dir, err := os.MkdirTemp(q.opts.Dir, "cln-spill-")
+		if err != nil {
+			return err
+		}
+		q.dir = dir
+	}
+
+	path := filepath.Join(q.dir, segmentName(uint64(q.files)))
+	if err := os.WriteFile(path, q.tail, 0o600); err != nil {
+		os.Remove(path)
+		return err
+	}
+
+	q.files++
+	q.spilled = append(q.spilled, path)
+	q.sizes = append(q.sizes, size)
+	q.diskBytes += size
+
+	q.tail, q.tailLen = q.tail[:0], 0
+	return nil
+}
+
+// Removes the element at the head of the queue and returns it along with true, or returns the zero value and false if
+// the queue is empty. When the in-memory head runs out, it is refilled from the oldest spilled file; if that fails,
+// the error is returned and no element is removed.
+func (q *spillQueue[T]) Take() (T, bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var zero T
+	if q.closed {
+		return zero, false, ErrClosed
+	}
+	if q.size == 0 {
+		return zero, false, nil
+	}
+
+	if q.headPos == len(q.head) {
+		if err := q.refill(); err != nil {
+			return zero, false, err
+		}
+	}
+
+	val := q.head[q.headPos]
+	q.head[q.headPos] = zero
+	q.headPos++
+	q.size--
+
+	return val, true, nil
+}
+
+// Replaces the exhausted head with the elements of the oldest spilled file, or with the tail if nothing is spilled.
+func (q *spillQueue[T]) refill() error {
+	if len(q.spilled) == 0 {
+		head, err := q.decode(bytes.NewReader(q.tail))
+		if err != nil {
+			return err
+		}
+
+		q.head, q.headPos = head, 0
+		q.tail, q.tailLen = q.tail[:0], 0
+		return nil
+	}
+
+	f, err := os.Open(q.spilled[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	head, err := q.decode(bufio.NewReader(f))
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(q.spilled[0]); err != nil {
+		return err
+	}
+
+	q.head, q.headPos = head, 0
+	q.diskBytes -= q.sizes[0]
+	q.spilled, q.sizes = q.spilled[1:], q.sizes[1:]
+	return nil
+}
+
+// Decodes the records read from r into the head's slice, which must be exhausted, and returns it.
+func (q *spillQueue[T]) decode(r io.Reader) ([]T, error) {
+	head := q.head[:0]
+	for {
+		payload, err := readRecord(r)
+		if err == io.EOF {
+			return head, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("durable: reading spilled records: %w", err)
+		}
+
+		val, err := q.codec.Decode(payload)
+		if err != nil {
+			return nil, fmt.Errorf("durable: decoding spilled element: %w", err)
+		}
+		head = append(head, val)
+	}
+}
+
+// Returns the amount of elements in the queue, whether in memory or spilled.
+func (q *spillQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.size
+}
+
+// Returns true if the queue contains no elements, otherwise returns false.
+func (q *spillQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Returns the amount of bytes the spilled elements take on disk.
+func (q *spillQueue[T]) DiskBytes() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.diskBytes
+}
+
+// Discards the elements of the queue and deletes its temporary files. Closing a closed queue does nothing.
+func (q *spillQueue[T]) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return nil
+	}
+	q.closed = true
+
+	q.head, q.tail, q.spilled, q.sizes = nil, nil, nil, nil
+	q.headPos, q.tailLen, q.size, q.diskBytes = 0, 0, 0, 0
+	if q.dir == "" {
+		return nil
+	}
+
+	return os.RemoveAll(q.dir)
+}
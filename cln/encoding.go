@@ -0,0 +1,98 @@
+package cln
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// The binary encoding of a collection starts with binaryMagic followed by a single version byte. The rest of the
+// encoding depends on the version:
+//
+// Version 1 is a gob stream holding the element count as an int, followed by each element in iteration order.
+const (
+	binaryMagic   = "cln"
+	binaryVersion = 1
+)
+
+// Writes the binary encoding of size elements to w, visiting them with each. Elements are encoded one at a time, so
+// the collection is never copied in memory.
+func encodeCollection[T comparable](w io.Writer, size int, each func(yield func(val T) bool)) error {
+	if _, err := io.WriteString(w, binaryMagic); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte{binaryVersion}); err != nil {
+		return err
+	}
+
+	enc := gob.NewEncoder(w)
+	if err := enc.Encode(size); err != nil {
+		return err
+	}
+
+	var err error
+	each(func(val T) bool {
+		err = enc.Encode(val)
+		return err == nil
+	})
+
+	return err
+}
+
+// Reads a binary encoding written by encodeCollection from r, passing each decoded element to add in order. The
+// decoder may read past the end of the encoding, so r should hold nothing else.
+func decodeCollection[T comparable](r io.Reader, add func(val T)) error {
+	header := make([]byte, len(binaryMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return fmt.Errorf("cln: reading header: %w", err)
+	}
+	if string(header[:len(binaryMagic)]) != binaryMagic {
+		return errors.New("cln: data is not an encoded collection")
+	}
+
+	switch version := header[len(binaryMagic)]; version {
+	case 1:
+		return decodeV1(r, add)
+	default:
+		return fmt.Errorf("cln: unsupported encoding version %d", version)
+	}
+}
+
+// Decodes the body of a version 1 encoding.
+func decodeV1[T comparable](r io.Reader, add func(val T)) error {
+	dec := gob.NewDecoder(r)
+
+	var size int
+	if err := dec.Decode(&size); err != nil {
+		return err
+	}
+	if size < 0 {
+		return fmt.Errorf("cln: negative element count %d", size)
+	}
+
+	for i := 0; i < size; i++ {
+		var val T
+		if err := dec.Decode(&val); err != nil {
+			return fmt.Errorf("cln: decoding element %d of %d: %w", i, size, err)
+		}
+		add(val)
+	}
+
+	return nil
+}
+
+// Returns a function that visits the elements of the given collection through Iter, draining the channel if visiting
+// stops early so that Iter's goroutine can exit.
+func iterEach[T comparable](c Collection[T]) func(yield func(val T) bool) {
+	return func(yield func(val T) bool) {
+		ch := c.Iter()
+		for v := range ch {
+			if !yield(v) {
+				for range ch {
+				}
+				return
+			}
+		}
+	}
+}
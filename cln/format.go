@@ -0,0 +1,127 @@
+package cln
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// The most elements a collection prints for the %v and %+v verbs when the verb sets no precision. Elements past the limit
+// are elided and counted instead, as in "[1 2 3 ...+97]". A limit of 0 or less prints every element. FormatLimit must
+// not be changed while collections are being formatted.
+var FormatLimit = 64
+
+// A layout describes how a collection is formatted: its type name for %+v, the names of its first and last elements in
+// iteration order, and the constructor that rebuilds it for %#v.
+type layout struct {
+	name        string
+	first, last string
+	constructor string
+}
+
+// Formats a collection of the given size, whose elements are visited in iteration order by each, for the fmt package:
+//
+//   - %v prints the elements in iteration order: [1 2 3]
+//   - %+v also names the collection and its ends and prints its size: queue{size: 3, head: 1, tail: 3, elems: [1 2 3]}
+//   - %#v prints a Go expression that rebuilds the collection: cln.QueueOf[int](1, 2, 3)
+//
+// %s is the same as %v. %v and %+v print at most FormatLimit elements, or the precision of the verb if one is given, as
+// in %.10v. %#v is never truncated. Any other verb is applied to each element, along with its flags and width.
+func formatCollection[T comparable](f fmt.State, verb rune, l layout, size int, each func(yield func(val T) bool)) {
+	if verb == 's' {
+		verb = 'v'
+	}
+
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%s[%s](", l.constructor, typeName[T]())
+		i := 0
+		each(func(v T) bool {
+			if i > 0 {
+				fmt.Fprint(f, ", ")
+			}
+			fmt.Fprintf(f, "%#v", v)
+			i++
+			return true
+		})
+		fmt.Fprint(f, ")")
+		return
+	}
+
+	limit := FormatLimit
+	if prec, ok := f.Precision(); ok {
+		limit = prec
+	}
+	if limit <= 0 {
+		limit = size
+	}
+
+	elem := elemDirective(f, verb)
+	var first, last T
+	shown := make([]T, 0, minInt(size, limit))
+	i := 0
+	each(func(v T) bool {
+		if i == 0 {
+			first = v
+		}
+		if i < limit {
+			shown = append(shown, v)
+		}
+		last = v
+		i++
+		return true
+	})
+
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, "%s{size: %d, ", l.name, size)
+		if size > 0 {
+			fmt.Fprintf(f, "%s: "+elem+", %s: "+elem+", ", l.first, first, l.last, last)
+		}
+		fmt.Fprint(f, "elems: ")
+	}
+
+	fmt.Fprint(f, "[")
+	for i, v := range shown {
+		if i > 0 {
+			fmt.Fprint(f, " ")
+		}
+		fmt.Fprintf(f, elem, v)
+	}
+	if elided := size - len(shown); elided > 0 {
+		fmt.Fprintf(f, " ...+%d", elided)
+	}
+	fmt.Fprint(f, "]")
+
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprint(f, "}")
+	}
+}
+
+// Returns the directive that formats a single element with the given verb and the flags and width of f. The precision
+// is left out, as it limits the amount of elements instead.
+func elemDirective(f fmt.State, verb rune) string {
+	directive := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			directive = append(directive, byte(flag))
+		}
+	}
+	if width, ok := f.Width(); ok {
+		directive = strconv.AppendInt(directive, int64(width), 10)
+	}
+
+	return string(append(directive, string(verb)...))
+}
+
+// Returns the smaller of a and b.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Returns the name of the type T as written in Go. Unlike printing a zero T with %T, this names interface types too,
+// whose zero value has no dynamic type.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
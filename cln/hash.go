@@ -0,0 +1,60 @@
+package cln
+
+import (
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// Returns a hash of the given value for the probabilistic structures, which use it unless given a hash function of
+// their own. Unlike defaultHash it is not seeded per process: strings, booleans and numbers, and arrays and structs made
+// only of them, hash the same in every process and on every platform, so structures holding such elements can be
+// serialized and merged across processes. Pointers and channels, and values holding them, hash by address, which only
+// means something within one process.
+//
+// Any hash function given in its place must, like it, give equal hashes to values that are equal by ==, so -0 and +0
+// hash alike, and should make every bit of the hash depend on every bit of the value; the structures derive all of
+// their indexes from the one 64 bit hash.
+func stableHash[T comparable](val T) uint64 {
+	switch v := any(val).(type) {
+	case string:
+		return hashString(v)
+	case int:
+		return mix64(uint64(v))
+	case int8:
+		return mix64(uint64(v))
+	case int16:
+		return mix64(uint64(v))
+	case int32:
+		return mix64(uint64(v))
+	case int64:
+		return mix64(uint64(v))
+	case uint:
+		return mix64(uint64(v))
+	case uint8:
+		return mix64(uint64(v))
+	case uint16:
+		return mix64(uint64(v))
+	case uint32:
+		return mix64(uint64(v))
+	case uint64:
+		return mix64(v)
+	case uintptr:
+		return mix64(uint64(v))
+	case float32:
+		return mix64(uint64(math.Float32bits(v + 0)))
+	case float64:
+		return mix64(math.Float64bits(v + 0))
+	default:
+		h := fnv.New64a()
+		h.Write(appendKey(nil, reflect.ValueOf(&val).Elem()))
+		return mix64(h.Sum64())
+	}
+}
+
+// Returns the FNV-1a hash of the given string, mixed so that every bit of the result depends on every bit of the input.
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return mix64(h.Sum64())
+}
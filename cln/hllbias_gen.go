@@ -0,0 +1,151 @@
+//go:build ignore
+
+// Generates hllbias.go, the bias correction tables of HyperLogLog, by simulating HyperLogLogs of every precision and
+// recording how far their mean raw estimate strays from the true cardinality, as the HyperLogLog++ paper does.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"math/bits"
+	"os"
+	"strconv"
+)
+
+const (
+	minPrecision = 4
+	maxPrecision = 18
+
+	// The most cardinality a table covers, as a multiple of the amount of registers. Bias correction only applies to
+	// estimates of up to 5 times the registers, so the tables reach a little past that.
+	maxLoad = 5.5
+	// The amount of cardinalities sampled per precision.
+	points = 111
+	// The amount of element additions simulated per precision, spread over as many trials as they allow.
+	budget = 1 << 27
+)
+
+func main() {
+	var raws, biases [][]float64
+	for p := minPrecision; p <= maxPrecision; p++ {
+		raw, bias := simulate(uint8(p))
+		raws, biases = append(raws, raw), append(biases, bias)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by hllbias_gen.go; DO NOT EDIT.\n\npackage cln\n\n")
+	buf.WriteString("// The mean raw estimates of HyperLogLogs of each precision from minPrecision to maxPrecision, at evenly spaced\n")
+	buf.WriteString("// cardinalities from 0 to 5.5 times the amount of registers, in increasing order.\n")
+	writeTable(&buf, "hllRawEstimates", raws)
+	buf.WriteString("\n// The mean amount by which the raw estimates in hllRawEstimates exceed the true cardinality.\n")
+	writeTable(&buf, "hllBiases", biases)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("hllbias.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Writes a table of the given name as a Go variable.
+func writeTable(buf *bytes.Buffer, name string, table [][]float64) {
+	fmt.Fprintf(buf, "var %s = [maxPrecision - minPrecision + 1][]float64{\n", name)
+	for _, row := range table {
+		buf.WriteString("{")
+		for i, v := range row {
+			if i%8 == 0 {
+				buf.WriteString("\n")
+			}
+			buf.WriteString(strconv.FormatFloat(v, 'f', 2, 64))
+			buf.WriteString(", ")
+		}
+		buf.WriteString("\n},\n")
+	}
+	buf.WriteString("}\n")
+}
+
+// Returns the mean raw estimate and the mean bias of HyperLogLogs of the given precision at each sampled cardinality.
+func simulate(p uint8) ([]float64, []float64) {
+	m := 1 << p
+
+	var ns []int
+	for i := 0; i < points; i++ {
+		n := int(math.Round(float64(i) * maxLoad * float64(m) / (points - 1)))
+		if len(ns) == 0 || n > ns[len(ns)-1] {
+			ns = append(ns, n)
+		}
+	}
+
+	trials := budget / ns[len(ns)-1]
+	if trials > 50000 {
+		trials = 50000
+	}
+
+	sums := make([]float64, len(ns))
+	rng := uint64(p)
+	registers := make([]uint8, m)
+	alpha := hllAlpha(m) * float64(m) * float64(m)
+	for t := 0; t < trials; t++ {
+		for i := range registers {
+			registers[i] = 0
+		}
+		sum := float64(m)
+
+		added := 0
+		for i, n := range ns {
+			for ; added < n; added++ {
+				rng += 0x9e3779b97f4a7c15
+				idx, rank := split(mix64(rng), p)
+				if old := registers[idx]; rank > old {
+					sum += math.Ldexp(1, -int(rank)) - math.Ldexp(1, -int(old))
+					registers[idx] = rank
+				}
+			}
+			sums[i] += alpha / sum
+		}
+	}
+
+	raw, bias := make([]float64, len(ns)), make([]float64, len(ns))
+	for i, n := range ns {
+		raw[i] = sums[i] / float64(trials)
+		bias[i] = raw[i] - float64(n)
+	}
+
+	log.Printf("precision %d: %d cardinalities, %d trials", p, len(ns), trials)
+	return raw, bias
+}
+
+// The following are copies of the functions of the same names in package cln.
+
+func split(x uint64, p uint8) (uint64, uint8) {
+	idx := x >> (64 - p)
+	rank := uint8(bits.LeadingZeros64(x<<p|1<<(p-1))) + 1
+	return idx, rank
+}
+
+func hllAlpha(m int) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/float64(m))
+	}
+}
+
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,6 @@
+//go:build !cln_debug
+
+package cln
+
+// Builds without the cln_debug tag skip invariant validation; see debug.go.
+const debug = false
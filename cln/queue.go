@@ -1,7 +1,11 @@
 package cln
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 )
 
@@ -12,10 +16,17 @@ import (
 // This queue is implemented using nodes, not slices or arrays; this decision has it's tradeoffs. A node implementation
 // enables the addition and removal of a node to the queue to be O(1) and the memory used by the queue to be O(n) - always.
 // On the other hand, a node based implementation is not as performant when adding many values (batches) at a single time consistently.
+//
+// A queue created with NewPooledQueue keeps up to a fixed amount of removed nodes in a free list and reuses them for
+// later additions, so that a queue in a steady producer/consumer loop stops allocating altogether.
 type queue[T comparable] struct {
 	head *node[T]
 	tail *node[T]
 	size int
+
+	free     *node[T] // removed nodes kept for reuse, linked through next
+	freeSize int
+	freeCap  int
 }
 
 // A node is a data object that holds a value and a reference to a following node. Nodes are used
@@ -30,14 +41,68 @@ func NewQueue[T comparable]() *queue[T] {
 	return &queue[T]{}
 }
 
+// Returns a new queue holding the given elements, from head to tail.
+func QueueOf[T comparable](vals ...T) *queue[T] {
+	q := NewQueue[T]()
+	q.Add(vals...)
+	return q
+}
+
+// Returns a new instance of a queue of the specified type that keeps up to maxFree removed nodes for reuse by later
+// additions. Nodes kept for reuse can be released with Trim. A negative maxFree is treated as 0, keeping no nodes.
+func NewPooledQueue[T comparable](maxFree int) *queue[T] {
+	if maxFree < 0 {
+		maxFree = 0
+	}
+
+	return &queue[T]{freeCap: maxFree}
+}
+
+// Returns a node holding the given value, reusing a node from the free list if there is one.
+func (q *queue[T]) newNode(val T) *node[T] {
+	if q.free == nil {
+		return &node[T]{val: val}
+	}
+
+	n := q.free
+	q.free = n.next
+	q.freeSize--
+	n.val, n.next = val, nil
+	return n
+}
+
+// Keeps the given removed node for reuse if the free list has room for it. The node's value is cleared either way so
+// that it isn't kept alive.
+func (q *queue[T]) release(n *node[T]) {
+	var zero T
+	n.val = zero
+	n.next = nil
+
+	if q.freeSize < q.freeCap {
+		n.next = q.free
+		q.free = n
+		q.freeSize++
+	}
+}
+
+// Releases all nodes kept for reuse so that they can be garbage collected.
+func (q *queue[T]) Trim() {
+	q.free = nil
+	q.freeSize = 0
+}
+
 // Adds element(s) to the tail-end of the queue.
 func (q *queue[T]) Add(vals ...T) {
+	if debug {
+		defer mustValidate(q)
+	}
+
 	for _, elem := range vals {
 		if q.head != nil {
-			q.tail.next = &node[T]{val: elem, next: nil}
+			q.tail.next = q.newNode(elem)
 			q.tail = q.tail.next
 		} else {
-			initialNode := &node[T]{val: elem}
+			initialNode := q.newNode(elem)
 			q.head = initialNode
 			q.tail = initialNode
 		}
@@ -46,15 +111,31 @@ func (q *queue[T]) Add(vals ...T) {
 	}
 }
 
+// Adds all elements of the given collection, in the other collection's iteration order, to the queue.
+func (q *queue[T]) AddAll(other Collection[T]) {
+	q.Add(values(other)...)
+}
+
 // Returns the value of the head of the queue and removes it. If the queue is empty, returns nil.
 func (q *queue[T]) Take() (T, bool) {
+	if debug {
+		defer mustValidate(q)
+	}
+
 	if q.size == 0 {
 		var zero T
 		return zero, false
 	}
 
-	val := q.head.val
-	q.head = q.head.next
+	taken := q.head
+	val := taken.val
+	q.head = taken.next
+	if q.head == nil {
+		q.tail = nil
+	}
+
+	q.release(taken)
+
 	q.size--
 	return val, true
 }
@@ -69,10 +150,22 @@ func (q *queue[T]) Peek() (T, bool) {
 	return q.head.val, true
 }
 
-// Removes all elements from the queue.
+// Removes all elements from the queue. A pooled queue keeps the removed nodes for reuse, up to its cap.
 func (q *queue[T]) Clear() {
-	cleared := NewQueue[T]()
-	*q = *cleared
+	if debug {
+		defer mustValidate(q)
+	}
+
+	// Keep nodes for reuse until the free list is full; the rest are left to the garbage collector.
+	for n := q.head; n != nil && q.freeSize < q.freeCap; {
+		next := n.next
+		q.release(n)
+		n = next
+	}
+
+	q.head = nil
+	q.tail = nil
+	q.size = 0
 }
 
 // Returns true if the queue contains the given element, returns false otherwise.
@@ -88,41 +181,156 @@ func (q *queue[T]) Contains(element T) bool {
 	return false
 }
 
-// Removes the first instance of the given element from the queue.
-func (q *queue[T]) Remove(val T) {
+// Returns true if the queue contains every one of the given elements, returns false otherwise.
+func (q *queue[T]) ContainsAll(vals ...T) bool {
+	return containsAll[T](q, vals)
+}
+
+// Returns true if the queue contains at least one of the given elements, returns false otherwise.
+func (q *queue[T]) ContainsAny(vals ...T) bool {
+	return containsAny[T](q, vals)
+}
+
+// Removes the first instance of the given element from the queue. Returns true if an element was removed, returns false
+// otherwise.
+func (q *queue[T]) Remove(val T) bool {
+	if debug {
+		defer mustValidate(q)
+	}
+
 	sentinel := &node[T]{next: q.head}
 
-	for sentinel.next != nil {
-		if sentinel.next.val == val {
-			sentinel.next = sentinel.next.next
+	for prev := sentinel; prev.next != nil; prev = prev.next {
+		if prev.next.val == val {
+			if prev.next == q.tail {
+				q.tail = prev
+			}
+
+			removed := prev.next
+			prev.next = removed.next
+			q.head = sentinel.next
+			if q.head == nil {
+				q.tail = nil
+			}
+
+			q.release(removed)
 			q.size--
-			return
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the index, counting from the head, of the first instance of the given element in the queue. Returns -1 if
+// the queue does not contain the element.
+func (q *queue[T]) IndexOf(val T) int {
+	i := 0
+	for head := q.head; head != nil; head = head.next {
+		if head.val == val {
+			return i
+		}
+		i++
+	}
+
+	return -1
+}
+
+// Returns the index, counting from the head, of the last instance of the given element in the queue. Returns -1 if
+// the queue does not contain the element.
+func (q *queue[T]) LastIndexOf(val T) int {
+	last := -1
+	i := 0
+	for head := q.head; head != nil; head = head.next {
+		if head.val == val {
+			last = i
 		}
+		i++
+	}
 
-		sentinel = sentinel.next
+	return last
+}
+
+// Removes every instance of each of the given elements from the queue and returns the amount of elements removed.
+func (q *queue[T]) RemoveAll(vals ...T) int {
+	if len(vals) == 0 {
+		return 0
 	}
+
+	return q.RemoveIf(memberOf(vals))
+}
+
+// Removes every instance of the given element from the queue and returns the amount of elements removed.
+func (q *queue[T]) RemoveEvery(val T) int {
+	return q.RemoveIf(func(v T) bool {
+		return v == val
+	})
+}
+
+// Removes all elements from the queue that are not contained in the given collection and returns the amount of
+// elements removed.
+func (q *queue[T]) RetainAll(other Collection[T]) int {
+	return q.RetainIf(memberOf(values(other)))
 }
 
 // Filters all elements from the queue that satisfy the given predicate.
+//
+// Deprecated: Filter removes the elements that satisfy the predicate, which is the opposite of what most expect from
+// a filter. Use RemoveIf, RetainIf or Where instead.
 func (q *queue[T]) Filter(filter func(val T) bool) {
-	if q.head == nil {
-		return
+	q.RemoveIf(filter)
+}
+
+// Removes all elements from the queue that satisfy the given predicate and returns the amount of elements removed.
+func (q *queue[T]) RemoveIf(pred func(val T) bool) int {
+	if debug {
+		defer mustValidate(q)
 	}
 
-	currNode := q.head
-	for currNode.next != nil {
-		if filter(currNode.next.val) {
-			currNode.next = currNode.next.next
-			q.size--
+	sentinel := &node[T]{next: q.head}
+	prev := sentinel
+	removed := 0
+
+	for prev.next != nil {
+		if pred(prev.next.val) {
+			removedNode := prev.next
+			prev.next = removedNode.next
+			q.release(removedNode)
+			removed++
 		} else {
-			currNode = currNode.next
+			prev = prev.next
 		}
 	}
 
-	if filter(q.head.val) {
-		q.head = q.head.next
-		q.size--
+	q.head = sentinel.next
+	if q.head == nil {
+		q.tail = nil
+	} else {
+		q.tail = prev
 	}
+
+	q.size -= removed
+	return removed
+}
+
+// Removes all elements from the queue that do not satisfy the given predicate and returns the amount of elements removed.
+func (q *queue[T]) RetainIf(pred func(val T) bool) int {
+	return q.RemoveIf(func(val T) bool {
+		return !pred(val)
+	})
+}
+
+// Returns a new queue containing, in order, the elements of the queue that satisfy the given predicate. The queue
+// itself is left unchanged.
+func (q *queue[T]) Where(pred func(val T) bool) Collection[T] {
+	matches := NewQueue[T]()
+	for head := q.head; head != nil; head = head.next {
+		if pred(head.val) {
+			matches.Add(head.val)
+		}
+	}
+
+	return matches
 }
 
 // Returns the amount of elements contained within the queue.
@@ -135,6 +343,101 @@ func (q *queue[T]) IsEmpty() bool {
 	return q.size == 0
 }
 
+// Returns an error describing the first broken invariant of the queue, or nil if the queue is consistent. The size must
+// match the amount of nodes reachable from the head, the tail must be the last of those nodes, and the free list must
+// hold exactly as many nodes as it counts, within its cap.
+func (q *queue[T]) Validate() error {
+	if q.size < 0 {
+		return fmt.Errorf("queue: negative size %d", q.size)
+	}
+
+	if q.freeSize > q.freeCap {
+		return fmt.Errorf("queue: free list holds %d nodes but is capped at %d", q.freeSize, q.freeCap)
+	}
+	freeCount := 0
+	for n := q.free; n != nil && freeCount <= q.freeSize; n = n.next {
+		freeCount++
+	}
+	if freeCount != q.freeSize {
+		return fmt.Errorf("queue: free list size is %d but it holds %d nodes", q.freeSize, freeCount)
+	}
+
+	if q.head == nil {
+		if q.tail != nil {
+			return errors.New("queue: tail is set but head is nil")
+		}
+		if q.size != 0 {
+			return fmt.Errorf("queue: head is nil but size is %d", q.size)
+		}
+		return nil
+	}
+
+	if q.tail == nil {
+		return errors.New("queue: head is set but tail is nil")
+	}
+	if q.tail.next != nil {
+		return errors.New("queue: tail is not the last node")
+	}
+
+	count := 1
+	last := q.head
+	for last.next != nil {
+		if count > q.size {
+			return fmt.Errorf("queue: more nodes are reachable from head than size %d", q.size)
+		}
+		last = last.next
+		count++
+	}
+
+	if count != q.size {
+		return fmt.Errorf("queue: size is %d but %d nodes are reachable from head", q.size, count)
+	}
+	if last != q.tail {
+		return errors.New("queue: tail is not reachable from head")
+	}
+
+	return nil
+}
+
+// Returns the structure of the queue: its nodes from head to tail, linked by their next pointers, the head and tail
+// pointers and, for pooled queues, the free list. Nodes are followed until one is visited twice, and a tail that can't
+// be reached from the head is drawn on its own, so that corrupt queues can be drawn too.
+func (q *queue[T]) Structure() Structure {
+	s := Structure{Name: "queue"}
+	ids := make(map[*node[T]]string)
+
+	idOf := func(n *node[T], prefix, group string) string {
+		if n == nil {
+			return ""
+		}
+		if id, ok := ids[n]; ok {
+			return id
+		}
+
+		id := fmt.Sprintf("%s%d", prefix, len(ids))
+		ids[n] = id
+		return s.addNode(id, fmt.Sprint(n.val), ElementNode, group)
+	}
+	chain := func(first *node[T], prefix, group string) string {
+		for n := first; n != nil && n.next != nil; n = n.next {
+			_, seen := ids[n.next]
+			s.addEdge(idOf(n, prefix, group), idOf(n.next, prefix, group), "next")
+			if seen {
+				break
+			}
+		}
+		return idOf(first, prefix, group)
+	}
+
+	s.addPointer("head", "head", chain(q.head, "node", "nodes"))
+	s.addPointer("tail", "tail", idOf(q.tail, "node", "nodes"))
+	if q.freeCap > 0 {
+		s.addPointer("free", fmt.Sprintf("free (%d/%d)", q.freeSize, q.freeCap), chain(q.free, "free", "free list"))
+	}
+
+	return s
+}
+
 // Returns a string representation of the queue. The larger the queue, the more expensive the operation.
 func (q *queue[T]) String() string {
 	var stringBuilder strings.Builder
@@ -152,6 +455,64 @@ func (q *queue[T]) String() string {
 	return stringBuilder.String()
 }
 
+// Formats the queue for the fmt package. %v prints its elements from head to tail, %+v also prints its size, head and
+// tail, and %#v prints a call to QueueOf that rebuilds it. Long queues are truncated; see FormatLimit.
+func (q *queue[T]) Format(f fmt.State, verb rune) {
+	formatCollection(f, verb, layout{"queue", "head", "tail", "cln.QueueOf"}, q.size, func(yield func(val T) bool) {
+		for n := q.head; n != nil && yield(n.val); n = n.next {
+		}
+	})
+}
+
+// Returns the queue encoded as a JSON array of its elements, from head to tail.
+func (q *queue[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(values[T](q))
+}
+
+// Replaces the elements of the queue with those of the given JSON array, restoring the order produced by MarshalJSON.
+func (q *queue[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONInto[T](q, data)
+}
+
+// Writes the binary encoding of the queue to w, one element at a time, from head to tail.
+func (q *queue[T]) EncodeTo(w io.Writer) error {
+	return encodeCollection(w, q.size, func(yield func(val T) bool) {
+		for head := q.head; head != nil && yield(head.val); head = head.next {
+		}
+	})
+}
+
+// Replaces the elements of the queue with those read from r, which must hold an encoding written by EncodeTo. On error
+// the queue holds the elements decoded so far.
+func (q *queue[T]) DecodeFrom(r io.Reader) error {
+	q.Clear()
+	return decodeCollection(r, func(val T) {
+		q.Add(val)
+	})
+}
+
+// Returns the binary encoding of the queue, as written by EncodeTo.
+func (q *queue[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := q.EncodeTo(&buf)
+	return buf.Bytes(), err
+}
+
+// Replaces the elements of the queue with those of the given binary encoding.
+func (q *queue[T]) UnmarshalBinary(data []byte) error {
+	return q.DecodeFrom(bytes.NewReader(data))
+}
+
+// Returns the binary encoding of the queue, for use by encoding/gob.
+func (q *queue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+// Replaces the elements of the queue with those of the given gob encoding.
+func (q *queue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
 // Returns a chan of the same type of the collection
 func (q *queue[T]) Iter() chan T {
 	c := make(chan T)
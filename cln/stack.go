@@ -1,43 +1,136 @@
 package cln
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 )
 
 // A Stack is a Collection implementation that maintains data in a LIFO (last-in-first-out) manner. All elements added
 // to the stack are added to the 'top' of the stack. Operations used to retrieve data return the value stored at the 'top'
 // of the stack.
 //
-// This stack is implemented using a slice, therefore it's size is dynamic.
+// This stack is implemented using a slice, therefore it's size is dynamic. Removed elements are zeroed so that they can
+// be garbage collected, and once fewer than a quarter of the slice's capacity is in use, the capacity is halved so that
+// memory claimed during a spike is given back. Capacity reserved with Grow is never given back automatically.
 type stack[T comparable] struct {
-	pile []T
+	pile     []T
+	reserved int // the capacity reserved by Grow, below which the stack never shrinks automatically
 }
 
+// The capacity at or below which the stack never shrinks automatically.
+const minShrinkCap = 16
+
 func NewStack[T comparable]() *stack[T] {
 	return &stack[T]{}
 }
 
+// Returns a new stack holding the given elements, from bottom to top.
+func StackOf[T comparable](vals ...T) *stack[T] {
+	st := NewStack[T]()
+	st.Add(vals...)
+	return st
+}
+
 // Adds element(s) to the top of the stack.
 func (st *stack[T]) Add(vals ...T) {
+	if debug {
+		defer mustValidate(st)
+	}
+
 	st.pile = append(st.pile, vals...)
 }
 
+// Adds all elements of the given collection, in the other collection's iteration order, to the stack.
+func (st *stack[T]) AddAll(other Collection[T]) {
+	st.Add(values(other)...)
+}
+
 // Removes the value at the top of the stack and returns it along with a bool value of true if the stack
 // is not empty, otherwise, it will return the zero value of the stack's type and a bool value of false.
 func (st *stack[T]) Take() (T, bool) {
+	if debug {
+		defer mustValidate(st)
+	}
+
 	if len(st.pile) == 0 {
 		var zero T
 		return zero, false
 	}
 
-	top := st.pile[len(st.pile)-1]
-	st.pile = st.pile[:len(st.pile)-1]
+	var zero T
+	last := len(st.pile) - 1
+	top := st.pile[last]
+	st.pile[last] = zero
+	st.pile = st.pile[:last]
+	st.shrink()
 	return top, true
 }
 
-// Removes all elements from the stack.
+// Halves the capacity of the stack if fewer than a quarter of it is in use, keeping at least the capacity reserved by
+// Grow.
+func (st *stack[T]) shrink() {
+	floor := minShrinkCap
+	if st.reserved > floor {
+		floor = st.reserved
+	}
+
+	if c := cap(st.pile); c > floor && len(st.pile) < c/4 {
+		size := c / 2
+		if size < st.reserved {
+			size = st.reserved
+		}
+		pile := make([]T, len(st.pile), size)
+		copy(pile, st.pile)
+		st.pile = pile
+	}
+}
+
+// Returns the amount of elements the stack can hold without reallocating.
+func (st *stack[T]) Cap() int {
+	return cap(st.pile)
+}
+
+// Grows the capacity of the stack, if necessary, so that n more elements can be added without reallocating. The
+// capacity reserved this way is kept when elements are taken, until ShrinkToFit or Clear releases it.
+func (st *stack[T]) Grow(n int) {
+	if r := len(st.pile) + n; r > st.reserved {
+		st.reserved = r
+	}
+
+	if n > cap(st.pile)-len(st.pile) {
+		pile := make([]T, len(st.pile), len(st.pile)+n)
+		copy(pile, st.pile)
+		st.pile = pile
+	}
+}
+
+// Reduces the capacity of the stack to the amount of elements it holds, releasing any capacity reserved by Grow.
+func (st *stack[T]) ShrinkToFit() {
+	st.reserved = 0
+	if len(st.pile) == cap(st.pile) {
+		return
+	}
+
+	if len(st.pile) == 0 {
+		st.pile = nil
+		return
+	}
+
+	pile := make([]T, len(st.pile))
+	copy(pile, st.pile)
+	st.pile = pile
+}
+
+// Removes all elements from the stack and releases its capacity, including any reserved by Grow.
 func (st *stack[T]) Clear() {
+	if debug {
+		defer mustValidate(st)
+	}
+
 	st.pile = make([]T, 0, 0)
+	st.reserved = 0
 }
 
 // Returns true if the stack contains the given element, returns false otherwise.
@@ -51,25 +144,134 @@ func (st *stack[T]) Contains(val T) bool {
 	return false
 }
 
-// Removes the first instance of the given element from the top of the stack.
-func (st *stack[T]) Remove(val T) {
-	if len(st.pile) > 0 {
-		for i := len(st.pile) - 1; i >= 0; i-- {
-			if st.pile[i] == val {
-				st.pile = append(st.pile[:i], st.pile[i+1:]...)
-				return
-			}
+// Returns true if the stack contains every one of the given elements, returns false otherwise.
+func (st *stack[T]) ContainsAll(vals ...T) bool {
+	return containsAll[T](st, vals)
+}
+
+// Returns true if the stack contains at least one of the given elements, returns false otherwise.
+func (st *stack[T]) ContainsAny(vals ...T) bool {
+	return containsAny[T](st, vals)
+}
+
+// Removes the first instance of the given element from the top of the stack. Returns true if an element was removed,
+// returns false otherwise.
+func (st *stack[T]) Remove(val T) bool {
+	if debug {
+		defer mustValidate(st)
+	}
+
+	i := st.LastIndexOf(val)
+	if i == -1 {
+		return false
+	}
+
+	var zero T
+	last := len(st.pile) - 1
+	copy(st.pile[i:], st.pile[i+1:])
+	st.pile[last] = zero
+	st.pile = st.pile[:last]
+	st.shrink()
+	return true
+}
+
+// Returns the index, counting from the bottom, of the first instance of the given element in the stack. Returns -1 if
+// the stack does not contain the element.
+func (st *stack[T]) IndexOf(val T) int {
+	for i, v := range st.pile {
+		if v == val {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Returns the index, counting from the bottom, of the last instance of the given element in the stack - the instance
+// closest to the top. Returns -1 if the stack does not contain the element.
+func (st *stack[T]) LastIndexOf(val T) int {
+	for i := len(st.pile) - 1; i >= 0; i-- {
+		if st.pile[i] == val {
+			return i
 		}
 	}
+
+	return -1
+}
+
+// Removes every instance of each of the given elements from the stack and returns the amount of elements removed.
+func (st *stack[T]) RemoveAll(vals ...T) int {
+	if len(vals) == 0 {
+		return 0
+	}
+
+	return st.RemoveIf(memberOf(vals))
+}
+
+// Removes every instance of the given element from the stack and returns the amount of elements removed.
+func (st *stack[T]) RemoveEvery(val T) int {
+	return st.RemoveIf(func(v T) bool {
+		return v == val
+	})
+}
+
+// Removes all elements from the stack that are not contained in the given collection and returns the amount of
+// elements removed.
+func (st *stack[T]) RetainAll(other Collection[T]) int {
+	return st.RetainIf(memberOf(values(other)))
 }
 
 // Filters all elements from the stack that satisfy the given predicate.
+//
+// Deprecated: Filter removes the elements that satisfy the predicate, which is the opposite of what most expect from
+// a filter. Use RemoveIf, RetainIf or Where instead.
 func (st *stack[T]) Filter(filter func(val T) bool) {
-	for i := len(st.pile) - 1; i >= 0; i-- {
-		if filter(st.pile[i]) {
-			st.pile = append(st.pile[:i], st.pile[i+1:]...)
+	st.RemoveIf(filter)
+}
+
+// Removes all elements from the stack that satisfy the given predicate and returns the amount of elements removed.
+// The relative order of the remaining elements is preserved.
+func (st *stack[T]) RemoveIf(pred func(val T) bool) int {
+	if debug {
+		defer mustValidate(st)
+	}
+
+	kept := st.pile[:0]
+	for _, v := range st.pile {
+		if !pred(v) {
+			kept = append(kept, v)
+		}
+	}
+
+	var zero T
+	for i := len(kept); i < len(st.pile); i++ {
+		st.pile[i] = zero
+	}
+
+	removed := len(st.pile) - len(kept)
+	st.pile = kept
+	st.shrink()
+	return removed
+}
+
+// Removes all elements from the stack that do not satisfy the given predicate and returns the amount of elements removed.
+func (st *stack[T]) RetainIf(pred func(val T) bool) int {
+	return st.RemoveIf(func(val T) bool {
+		return !pred(val)
+	})
+}
+
+// Returns a new stack containing the elements of the stack that satisfy the given predicate, in the same bottom-to-top
+// order. The stack itself is left unchanged.
+func (st *stack[T]) Where(pred func(val T) bool) Collection[T] {
+	matches := NewStack[T]()
+	for _, v := range st.pile {
+		if pred(v) {
+			matches.Add(v)
 		}
 	}
+
+	return matches
 }
 
 // Returns the amount of elements contained within the stack.
@@ -86,11 +288,101 @@ func (st *stack[T]) IsEmpty() bool {
 	return false
 }
 
+// Returns an error describing the first broken invariant of the stack, or nil if the stack is consistent. Every slot
+// past the top of the stack must hold the zero value so that removed elements aren't kept alive.
+func (st *stack[T]) Validate() error {
+	var zero T
+	for i, v := range st.pile[len(st.pile):cap(st.pile)] {
+		if v != zero {
+			return fmt.Errorf("stack: vacated slot %d holds %v", len(st.pile)+i, v)
+		}
+	}
+
+	return nil
+}
+
+// Returns the structure of the stack: every slot of its slice, from bottom to top, including the spare capacity, and
+// the top pointer.
+func (st *stack[T]) Structure() Structure {
+	s := arrayStructure("stack", fmt.Sprintf("pile (len %d, cap %d)", len(st.pile), cap(st.pile)), st.pile)
+
+	top := ""
+	if len(st.pile) > 0 {
+		top = fmt.Sprintf("slot%d", len(st.pile)-1)
+	}
+	s.addPointer("top", "top", top)
+	return s
+}
+
 // Returns a string representation of the stack.
 func (st *stack[T]) String() string {
 	return fmt.Sprint(st.pile)
 }
 
+// Formats the stack for the fmt package. %v prints its elements from bottom to top, %+v also prints its size, bottom
+// and top, and %#v prints a call to StackOf that rebuilds it. Tall stacks are truncated; see FormatLimit.
+func (st *stack[T]) Format(f fmt.State, verb rune) {
+	formatCollection(f, verb, layout{"stack", "bottom", "top", "cln.StackOf"}, len(st.pile), func(yield func(val T) bool) {
+		for _, v := range st.pile {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Returns the stack encoded as a JSON array of its elements, from bottom to top.
+func (st *stack[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(values[T](st))
+}
+
+// Replaces the elements of the stack with those of the given JSON array, restoring the order produced by MarshalJSON.
+func (st *stack[T]) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONInto[T](st, data)
+}
+
+// Writes the binary encoding of the stack to w, one element at a time, from bottom to top.
+func (st *stack[T]) EncodeTo(w io.Writer) error {
+	return encodeCollection(w, len(st.pile), func(yield func(val T) bool) {
+		for _, v := range st.pile {
+			if !yield(v) {
+				return
+			}
+		}
+	})
+}
+
+// Replaces the elements of the stack with those read from r, which must hold an encoding written by EncodeTo. On error
+// the stack holds the elements decoded so far.
+func (st *stack[T]) DecodeFrom(r io.Reader) error {
+	st.Clear()
+	return decodeCollection(r, func(val T) {
+		st.Add(val)
+	})
+}
+
+// Returns the binary encoding of the stack, as written by EncodeTo.
+func (st *stack[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := st.EncodeTo(&buf)
+	return buf.Bytes(), err
+}
+
+// Replaces the elements of the stack with those of the given binary encoding.
+func (st *stack[T]) UnmarshalBinary(data []byte) error {
+	return st.DecodeFrom(bytes.NewReader(data))
+}
+
+// Returns the binary encoding of the stack, for use by encoding/gob.
+func (st *stack[T]) GobEncode() ([]byte, error) {
+	return st.MarshalBinary()
+}
+
+// Replaces the elements of the stack with those of the given gob encoding.
+func (st *stack[T]) GobDecode(data []byte) error {
+	return st.UnmarshalBinary(data)
+}
+
 // Returns a chan of the same type of the collection
 func (st *stack[T]) Iter() chan T {
 	c := make(chan T)
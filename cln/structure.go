@@ -0,0 +1,88 @@
+package cln
+
+import "fmt"
+
+// A Structure is a graph of the internal layout of a collection - its nodes, slots and the pointers into them - for
+// debugging. Collections return one from their Structure method, and the viz package renders it as Graphviz DOT or
+// Mermaid text.
+type Structure struct {
+	// The name of the collection's type, such as "queue".
+	Name string
+
+	// If true, the graph is best drawn top to bottom, as for trees. Otherwise it is drawn left to right, as for lists
+	// and arrays.
+	TopDown bool
+
+	Nodes []StructureNode
+	Edges []StructureEdge
+}
+
+// A NodeKind tells what a StructureNode stands for.
+type NodeKind int
+
+const (
+	// An element of the collection, or a node or slot holding one.
+	ElementNode NodeKind = iota
+	// A slot of an array that is allocated but holds no element.
+	EmptySlotNode
+	// A named pointer into the structure, such as a queue's head.
+	PointerNode
+	// A nil pointer.
+	NilNode
+)
+
+// A StructureNode is a node of a Structure.
+type StructureNode struct {
+	// Identifies the node within its Structure. IDs are made of ASCII letters, digits and underscores.
+	ID    string
+	Label string
+	Kind  NodeKind
+
+	// Nodes sharing a group, such as the slots of an array, are drawn together under the group's name.
+	Group string
+
+	// An optional fill color, such as the color of a red-black tree node.
+	Color string
+}
+
+// A StructureEdge is a reference from one node of a Structure to another.
+type StructureEdge struct {
+	From, To string
+	Label    string
+}
+
+// Adds a node to the structure and returns its ID.
+func (s *Structure) addNode(id, label string, kind NodeKind, group string) string {
+	s.Nodes = append(s.Nodes, StructureNode{ID: id, Label: label, Kind: kind, Group: group})
+	return id
+}
+
+// Adds an edge to the structure.
+func (s *Structure) addEdge(from, to, label string) {
+	s.Edges = append(s.Edges, StructureEdge{From: from, To: to, Label: label})
+}
+
+// Adds a pointer node with the given ID and label and an edge from it to the node with the given ID, or to a nil node
+// if that ID is empty.
+func (s *Structure) addPointer(id, label, to string) {
+	s.addNode(id, label, PointerNode, "")
+	if to == "" {
+		to = s.addNode(id+"_nil", "nil", NilNode, "")
+	}
+	s.addEdge(id, to, "")
+}
+
+// Returns the structure of an array holding the given elements in its first slots and spare capacity in the rest.
+func arrayStructure[T comparable](name, group string, vals []T) Structure {
+	s := Structure{Name: name}
+	for i, v := range vals[:cap(vals)] {
+		id := fmt.Sprintf("slot%d", i)
+		if i < len(vals) {
+			s.addNode(id, fmt.Sprint(v), ElementNode, group)
+		} else {
+			s.addNode(id, "", EmptySlotNode, group)
+		}
+	}
+
+	return s
+}
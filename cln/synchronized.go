@@ -0,0 +1,350 @@
+package cln
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// A synchronized is a Collection that guards another Collection with a sync.RWMutex so that it can be shared between
+// goroutines. Methods that only read the collection hold the read lock, letting readers run in parallel, while methods
+// that modify it hold the write lock.
+//
+// Iter iterates over a snapshot taken under the read lock, so callers may iterate for as long as they like - and modify
+// the collection while doing so - without blocking writers. Compound operations that must not be interleaved with other
+// goroutines, such as check-then-act, should be done through Atomically.
+type synchronized[T comparable] struct {
+	mu sync.RWMutex
+	c  Collection[T]
+}
+
+// Returns a thread-safe Collection backed by the given collection. The given collection must not be used directly
+// afterwards.
+func Synchronized[T comparable](c Collection[T]) *synchronized[T] {
+	return &synchronized[T]{c: c}
+}
+
+// Calls f with the underlying collection while holding the write lock, so that everything f does to the collection
+// happens as a single atomic operation. f must not keep the collection after it returns, nor use the synchronized
+// collection itself, which would deadlock.
+func (s *synchronized[T]) Atomically(f func(c Collection[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f(s.c)
+}
+
+// Adds element(s) to the collection.
+func (s *synchronized[T]) Add(vals ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.c.Add(vals...)
+}
+
+// Adds all elements of the given collection to the collection. The other collection is read before the lock is taken,
+// so it may be the synchronized collection itself.
+func (s *synchronized[T]) AddAll(other Collection[T]) {
+	vals := values(other)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.c.Add(vals...)
+}
+
+// Removes an element from the collection and returns it along with a bool value of true if the collection is not
+// empty, otherwise, it will return the zero value of the collection's type and a bool value of false.
+func (s *synchronized[T]) Take() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.Take()
+}
+
+// Returns true if the collection contains the given element, returns false otherwise.
+func (s *synchronized[T]) Contains(val T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.Contains(val)
+}
+
+// Returns true if the collection contains every one of the given elements, returns false otherwise.
+func (s *synchronized[T]) ContainsAll(vals ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.ContainsAll(vals...)
+}
+
+// Returns true if the collection contains at least one of the given elements, returns false otherwise.
+func (s *synchronized[T]) ContainsAny(vals ...T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.ContainsAny(vals...)
+}
+
+// Returns the index, in iteration order, of the first instance of the given element in the collection. Returns -1 if
+// the collection does not contain the element.
+func (s *synchronized[T]) IndexOf(val T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.IndexOf(val)
+}
+
+// Returns the index, in iteration order, of the last instance of the given element in the collection. Returns -1 if
+// the collection does not contain the element.
+func (s *synchronized[T]) LastIndexOf(val T) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.LastIndexOf(val)
+}
+
+// Removes the first instance of the given element from the collection. Returns true if an element was removed, returns
+// false otherwise.
+func (s *synchronized[T]) Remove(val T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.Remove(val)
+}
+
+// Removes every instance of each of the given elements from the collection and returns the amount of elements removed.
+func (s *synchronized[T]) RemoveAll(vals ...T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.RemoveAll(vals...)
+}
+
+// Removes every instance of the given element from the collection and returns the amount of elements removed.
+func (s *synchronized[T]) RemoveEvery(val T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.RemoveEvery(val)
+}
+
+// Removes all elements from the collection that are not contained in the given collection and returns the amount of
+// elements removed. The other collection is read before the lock is taken, so it may be the synchronized collection
+// itself.
+func (s *synchronized[T]) RetainAll(other Collection[T]) int {
+	isMember := memberOf(values(other))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.RetainIf(isMember)
+}
+
+// Filters all elements from the collection that satisfy the given predicate.
+//
+// Deprecated: Filter removes the elements that satisfy the predicate, which is the opposite of what most expect from
+// a filter. Use RemoveIf, RetainIf or Where instead.
+func (s *synchronized[T]) Filter(filter func(val T) bool) {
+	s.RemoveIf(filter)
+}
+
+// Removes all elements from the collection that satisfy the given predicate and returns the amount of elements
+// removed. The predicate is called while the write lock is held.
+func (s *synchronized[T]) RemoveIf(pred func(val T) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.RemoveIf(pred)
+}
+
+// Removes all elements from the collection that do not satisfy the given predicate and returns the amount of elements
+// removed. The predicate is called while the write lock is held.
+func (s *synchronized[T]) RetainIf(pred func(val T) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.c.RetainIf(pred)
+}
+
+// Returns a new synchronized collection containing the elements of the collection that satisfy the given predicate.
+// The predicate is called while the read lock is held.
+func (s *synchronized[T]) Where(pred func(val T) bool) Collection[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return Synchronized(s.c.Where(pred))
+}
+
+// Removes all elements from the collection.
+func (s *synchronized[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.c.Clear()
+}
+
+// Returns the amount of elements contained within the collection.
+func (s *synchronized[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.Size()
+}
+
+// Returns true if the collection contains no elements, otherwise returns false.
+func (s *synchronized[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.IsEmpty()
+}
+
+// Writes the binary encoding of the collection to w, one element at a time, in iteration order, while holding the
+// read lock.
+func (s *synchronized[T]) EncodeTo(w io.Writer) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return encodeCollection(w, s.c.Size(), iterEach(s.c))
+}
+
+// Replaces the elements of the collection with those read from r, which must hold an encoding written by EncodeTo.
+// The write lock is held throughout, and on error the collection holds the elements decoded so far.
+func (s *synchronized[T]) DecodeFrom(r io.Reader) error {
+	if s.c == nil {
+		return errors.New("synchronized: cannot decode into a collection not created by Synchronized")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.c.Clear()
+	return decodeCollection(r, func(val T) {
+		s.c.Add(val)
+	})
+}
+
+// Returns the binary encoding of the collection, as written by EncodeTo.
+func (s *synchronized[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := s.EncodeTo(&buf)
+	return buf.Bytes(), err
+}
+
+// Replaces the elements of the collection with those of the given binary encoding.
+func (s *synchronized[T]) UnmarshalBinary(data []byte) error {
+	return s.DecodeFrom(bytes.NewReader(data))
+}
+
+// Returns the binary encoding of the collection, for use by encoding/gob.
+func (s *synchronized[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// Replaces the elements of the collection with those of the given gob encoding.
+func (s *synchronized[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// Returns a chan of the same type of the collection, fed from a snapshot of the collection taken when Iter is called.
+func (s *synchronized[T]) Iter() chan T {
+	s.mu.RLock()
+	snapshot := values(s.c)
+	s.mu.RUnlock()
+
+	c := make(chan T)
+	go func() {
+		for _, v := range snapshot {
+			c <- v
+		}
+		close(c)
+	}()
+	return c
+}
+
+// Returns the structure of the underlying collection while holding the read lock. Collections without a Structure
+// method are drawn as an array of their elements in iteration order.
+func (s *synchronized[T]) Structure() Structure {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var structure Structure
+	if structured, ok := s.c.(interface{ Structure() Structure }); ok {
+		structure = structured.Structure()
+	} else {
+		vals := values(s.c)
+		structure = arrayStructure("collection", "elements", vals[:len(vals):len(vals)])
+	}
+
+	structure.Name = "synchronized " + structure.Name
+	return structure
+}
+
+// Returns a string representation of the collection.
+func (s *synchronized[T]) String() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.String()
+}
+
+// Formats the underlying collection for the fmt package while holding the read lock. %#v wraps the underlying
+// collection's Go syntax in a call to Synchronized. Collections that don't implement fmt.Formatter are formatted by
+// iterating over them, naming their ends "first" and "last", and are rebuilt by QueueOf, which keeps their iteration
+// order.
+func (s *synchronized[T]) Format(f fmt.State, verb rune) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "cln.Synchronized[%s](", typeName[T]())
+		defer fmt.Fprint(f, ")")
+	}
+
+	if formatter, ok := s.c.(fmt.Formatter); ok {
+		formatter.Format(f, verb)
+		return
+	}
+	formatCollection(f, verb, layout{"synchronized", "first", "last", "cln.QueueOf"}, s.c.Size(), iterEach(s.c))
+}
+
+// Returns the underlying collection encoded as a JSON array of its elements, in iteration order.
+func (s *synchronized[T]) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return json.Marshal(values(s.c))
+}
+
+// Replaces the elements of the underlying collection with those of the given JSON array, added in the array's order,
+// as a single atomic operation. A synchronized collection has no underlying collection unless it was created by
+// Synchronized, so it can't be decoded into a zero value.
+func (s *synchronized[T]) UnmarshalJSON(data []byte) error {
+	if s.c == nil {
+		return errors.New("synchronized: cannot decode into a collection not created by Synchronized")
+	}
+
+	var vals []T
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.c.Clear()
+	s.c.Add(vals...)
+	return nil
+}
+
+// Returns an error describing the first broken invariant of the underlying collection, or nil if it is consistent.
+func (s *synchronized[T]) Validate() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.c.Validate()
+}
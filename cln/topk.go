@@ -0,0 +1,160 @@
+package cln
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+)
+
+// A HeavyHitter is an element tracked by a top-k tracker along with its estimated count.
+type HeavyHitter[T comparable] struct {
+	Val   T
+	Count uint64
+}
+
+// A topK tracks the k most frequent elements of a stream - its heavy hitters - in bounded memory. Every element is
+// counted by a count-min sketch, and the k elements with the highest estimates seen so far are kept in a min-heap, so
+// that an element only needs to beat the least frequent of them to take its place.
+//
+// Counts are the sketch's estimates, so they may exceed the true counts, and an element that was frequent early on but
+// then crowded out can't come back until its estimate beats the heap's minimum again. A top-k tracker is not safe for
+// concurrent use.
+type topK[T comparable] struct {
+	k      int
+	sketch *countMinSketch[T]
+	heap   hitterHeap[T]
+}
+
+// A hitterHeap is a min-heap of heavy hitters ordered by count, which also knows the position of each element so that
+// an element's count can be raised in place.
+type hitterHeap[T comparable] struct {
+	hitters []HeavyHitter[T]
+	index   map[T]int
+}
+
+// Returns the amount of heavy hitters in the heap.
+func (h *hitterHeap[T]) Len() int {
+	return len(h.hitters)
+}
+
+// Returns true if the heavy hitter at index i has a lower count than the one at index j.
+func (h *hitterHeap[T]) Less(i, j int) bool {
+	return h.hitters[i].Count < h.hitters[j].Count
+}
+
+// Swaps the heavy hitters at the given indexes.
+func (h *hitterHeap[T]) Swap(i, j int) {
+	h.hitters[i], h.hitters[j] = h.hitters[j], h.hitters[i]
+	h.index[h.hitters[i].Val] = i
+	h.index[h.hitters[j].Val] = j
+}
+
+// Adds a heavy hitter at the end of the heap; used by container/heap.
+func (h *hitterHeap[T]) Push(x any) {
+	hitter := x.(HeavyHitter[T])
+	h.index[hitter.Val] = len(h.hitters)
+	h.hitters = append(h.hitters, hitter)
+}
+
+// Removes and returns the heavy hitter at the end of the heap; used by container/heap.
+func (h *hitterHeap[T]) Pop() any {
+	last := h.hitters[len(h.hitters)-1]
+	h.hitters = h.hitters[:len(h.hitters)-1]
+	delete(h.index, last.Val)
+	return last
+}
+
+// Returns a new, empty tracker of the k most frequent elements, counting elements with a count-min sketch of the given
+// accuracy; see NewCountMinSketch.
+func NewTopK[T comparable](k int, epsilon, delta float64) *topK[T] {
+	return NewTopKWithHasher(k, epsilon, delta, stableHash[T])
+}
+
+// Returns a new, empty tracker of the k most frequent elements, counting elements with a count-min sketch of the given
+// accuracy that uses the given hash function.
+func NewTopKWithHasher[T comparable](k int, epsilon, delta float64, hash func(val T) uint64) *topK[T] {
+	if k < 1 {
+		panic(fmt.Sprintf("cln: top-k tracker must track at least 1 element, not %d", k))
+	}
+
+	return &topK[T]{
+		k:      k,
+		sketch: NewCountMinSketchWithHasher(epsilon, delta, hash),
+		heap:   hitterHeap[T]{index: make(map[T]int)},
+	}
+}
+
+// Counts one more occurrence of the given element and returns its new estimated count.
+func (t *topK[T]) Increment(val T) uint64 {
+	return t.IncrementBy(val, 1)
+}
+
+// Counts the given amount of occurrences of the given element and returns its new estimated count.
+func (t *topK[T]) IncrementBy(val T, count uint64) uint64 {
+	estimate := t.sketch.IncrementBy(val, count)
+	t.offer(val, estimate)
+	return estimate
+}
+
+// Tracks the given element with the given estimate if it is tracked already, if there is room, or if it beats the least
+// frequent element tracked.
+func (t *topK[T]) offer(val T, estimate uint64) {
+	if i, ok := t.heap.index[val]; ok {
+		t.heap.hitters[i].Count = estimate
+		heap.Fix(&t.heap, i)
+		return
+	}
+
+	if t.heap.Len() < t.k {
+		heap.Push(&t.heap, HeavyHitter[T]{val, estimate})
+		return
+	}
+
+	if estimate > t.heap.hitters[0].Count {
+		delete(t.heap.index, t.heap.hitters[0].Val)
+		t.heap.hitters[0] = HeavyHitter[T]{val, estimate}
+		t.heap.index[val] = 0
+		heap.Fix(&t.heap, 0)
+	}
+}
+
+// Returns the estimated amount of occurrences of the given element, whether it is among the top k or not.
+func (t *topK[T]) Estimate(val T) uint64 {
+	return t.sketch.Estimate(val)
+}
+
+// Returns the tracked elements, at most k, from the most frequent to the least. Elements with equal counts are in no
+// particular order.
+func (t *topK[T]) TopK() []HeavyHitter[T] {
+	top := append([]HeavyHitter[T](nil), t.heap.hitters...)
+	sort.Slice(top, func(i, j int) bool {
+		return top[i].Count > top[j].Count
+	})
+
+	return top
+}
+
+// Adds the counts of the other tracker to the tracker, so that it tracks the heavy hitters of both streams together.
+// Elements tracked by either tracker are ranked by their estimates in the merged sketch. Returns an error, leaving the
+// tracker unchanged, if the trackers' sketches can't be merged.
+func (t *topK[T]) Merge(other *topK[T]) error {
+	if err := t.sketch.Merge(other.sketch); err != nil {
+		return err
+	}
+
+	candidates := append(append([]HeavyHitter[T](nil), t.heap.hitters...), other.heap.hitters...)
+	t.heap = hitterHeap[T]{index: make(map[T]int)}
+	for _, c := range candidates {
+		if _, ok := t.heap.index[c.Val]; !ok {
+			t.offer(c.Val, t.sketch.Estimate(c.Val))
+		}
+	}
+
+	return nil
+}
+
+// Resets every count to zero and stops tracking every element.
+func (t *topK[T]) Clear() {
+	t.sketch.Clear()
+	t.heap = hitterHeap[T]{index: make(map[T]int)}
+}
@@ -0,0 +1,202 @@
+// Package viz renders the internal layout of collections - as described by their Structure method - as Graphviz DOT
+// or Mermaid flowchart text, so that nodes, slots and pointers can be seen while debugging:
+//
+//	fmt.Println(viz.DOT(q.Structure()))
+//
+// Element nodes are drawn as boxes and spare array slots as dashed boxes. Named pointers such as head or top are drawn
+// as plain labels, and nil pointers as points.
+package viz
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Returns the given structure as a Graphviz DOT digraph.
+func DOT(s cln.Structure) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(s.Name))
+	if !s.TopDown {
+		b.WriteString("\trankdir=LR;\n")
+	}
+	b.WriteString("\tnode [shape=box];\n")
+
+	groups, ungrouped := groupNodes(s.Nodes)
+	for _, n := range ungrouped {
+		fmt.Fprintf(&b, "\t%s;\n", dotNode(n))
+	}
+	for i, g := range groups {
+		fmt.Fprintf(&b, "\tsubgraph cluster_%d {\n\t\tlabel=%s;\n", i, dotQuote(g.name))
+		for _, n := range g.nodes {
+			fmt.Fprintf(&b, "\t\t%s;\n", dotNode(n))
+		}
+		// Invisible edges keep the nodes of the group in order.
+		for j := 1; j < len(g.nodes); j++ {
+			fmt.Fprintf(&b, "\t\t%s -> %s [style=invis];\n", dotQuote(g.nodes[j-1].ID), dotQuote(g.nodes[j].ID))
+		}
+		b.WriteString("\t}\n")
+	}
+
+	for _, e := range s.Edges {
+		fmt.Fprintf(&b, "\t%s -> %s", dotQuote(e.From), dotQuote(e.To))
+		if e.Label != "" {
+			fmt.Fprintf(&b, " [label=%s]", dotQuote(e.Label))
+		}
+		b.WriteString(";\n")
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Returns the DOT statement declaring the given node.
+func dotNode(n cln.StructureNode) string {
+	attrs := []string{"label=" + dotQuote(n.Label)}
+	var styles []string
+
+	switch n.Kind {
+	case cln.EmptySlotNode:
+		styles = append(styles, "dashed")
+	case cln.PointerNode:
+		attrs = append(attrs, "shape=plaintext")
+	case cln.NilNode:
+		attrs = append(attrs, "shape=point")
+	}
+	if n.Color != "" {
+		styles = append(styles, "filled")
+		attrs = append(attrs, "fillcolor="+dotQuote(n.Color))
+	}
+	if len(styles) > 0 {
+		attrs = append(attrs, "style="+dotQuote(strings.Join(styles, ",")))
+	}
+
+	return fmt.Sprintf("%s [%s]", dotQuote(n.ID), strings.Join(attrs, ", "))
+}
+
+// Returns the given text as a quoted DOT string.
+func dotQuote(text string) string {
+	text = strings.ReplaceAll(text, `\`, `\\`)
+	text = strings.ReplaceAll(text, `"`, `\"`)
+	text = strings.ReplaceAll(text, "\n", `\n`)
+	return `"` + text + `"`
+}
+
+// Returns the given structure as a Mermaid flowchart.
+func Mermaid(s cln.Structure) string {
+	var b strings.Builder
+
+	if s.TopDown {
+		b.WriteString("flowchart TD\n")
+	} else {
+		b.WriteString("flowchart LR\n")
+	}
+	fmt.Fprintf(&b, "\t%%%% %s\n", strings.ReplaceAll(s.Name, "\n", " "))
+
+	groups, ungrouped := groupNodes(s.Nodes)
+	for _, n := range ungrouped {
+		fmt.Fprintf(&b, "\t%s\n", mermaidNode(n))
+	}
+	for i, g := range groups {
+		fmt.Fprintf(&b, "\tsubgraph group_%d [%s]\n\t\tdirection LR\n", i, mermaidQuote(g.name))
+		for _, n := range g.nodes {
+			fmt.Fprintf(&b, "\t\t%s\n", mermaidNode(n))
+		}
+		for j := 1; j < len(g.nodes); j++ {
+			fmt.Fprintf(&b, "\t\t%s ~~~ %s\n", g.nodes[j-1].ID, g.nodes[j].ID)
+		}
+		b.WriteString("\tend\n")
+	}
+
+	for _, e := range s.Edges {
+		if e.Label == "" {
+			fmt.Fprintf(&b, "\t%s --> %s\n", e.From, e.To)
+		} else {
+			fmt.Fprintf(&b, "\t%s -->|%s| %s\n", e.From, mermaidQuote(e.Label), e.To)
+		}
+	}
+
+	for _, n := range s.Nodes {
+		switch {
+		case n.Color != "":
+			fmt.Fprintf(&b, "\tstyle %s fill:%s\n", n.ID, n.Color)
+		case n.Kind == cln.EmptySlotNode:
+			fmt.Fprintf(&b, "\tstyle %s stroke-dasharray: 5 5\n", n.ID)
+		}
+	}
+
+	return b.String()
+}
+
+// Returns the Mermaid statement declaring the given node.
+func mermaidNode(n cln.StructureNode) string {
+	label := mermaidQuote(n.Label)
+
+	switch n.Kind {
+	case cln.EmptySlotNode:
+		return fmt.Sprintf(`%s[" "]`, n.ID)
+	case cln.PointerNode:
+		return fmt.Sprintf("%s([%s])", n.ID, label)
+	case cln.NilNode:
+		return fmt.Sprintf("%s((%s))", n.ID, label)
+	default:
+		return fmt.Sprintf("%s[%s]", n.ID, label)
+	}
+}
+
+// Returns the given text as a quoted Mermaid label.
+func mermaidQuote(text string) string {
+	text = strings.ReplaceAll(text, `"`, "#quot;")
+	text = strings.ReplaceAll(text, "\n", "<br>")
+	return `"` + text + `"`
+}
+
+// A group is a named set of nodes drawn together.
+type group struct {
+	name  string
+	nodes []cln.StructureNode
+}
+
+// Splits the given nodes into groups, in the order each group first appears, and the nodes that belong to none.
+func groupNodes(nodes []cln.StructureNode) ([]*group, []cln.StructureNode) {
+	var groups []*group
+	var ungrouped []cln.StructureNode
+	byName := make(map[string]*group)
+
+	for _, n := range nodes {
+		if n.Group == "" {
+			ungrouped = append(ungrouped, n)
+			continue
+		}
+
+		g, ok := byName[n.Group]
+		if !ok {
+			g = &group{name: n.Group}
+			byName[n.Group] = g
+			groups = append(groups, g)
+		}
+		g.nodes = append(g.nodes, n)
+	}
+
+	return groups, ungrouped
+}
+
+// Returns the structure of a binary heap stored in the given slice, as used by container/heap: the element at index i
+// is the parent of those at indexes 2i+1 and 2i+2. Each node is labeled with its index and element.
+func Heap[T any](vals []T) cln.Structure {
+	s := cln.Structure{Name: "heap", TopDown: true}
+	for i, v := range vals {
+		s.Nodes = append(s.Nodes, cln.StructureNode{
+			ID:    fmt.Sprintf("heap%d", i),
+			Label: fmt.Sprintf("[%d] %v", i, v),
+			Kind:  cln.ElementNode,
+		})
+		if i > 0 {
+			s.Edges = append(s.Edges, cln.StructureEdge{From: fmt.Sprintf("heap%d", (i-1)/2), To: fmt.Sprintf("heap%d", i)})
+		}
+	}
+
+	return s
+}
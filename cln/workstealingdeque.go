@@ -0,0 +1,142 @@
+package cln
+
+import "sync/atomic"
+
+// The capacity of a new work-stealing deque's circular array.
+const minDequeCap = 32
+
+// A workStealingDeque is a Chase-Lev work-stealing deque, the building block of task schedulers in which every worker
+// owns a deque of tasks. The owning goroutine pushes and pops tasks at the 'bottom' of its deque without taking any
+// lock, while other goroutines - thieves - steal tasks from the 'top' when they run out of their own. Owner and thieves
+// only contend, through a single compare-and-swap, when they go after the last task.
+//
+// Elements are kept in a circular array that doubles in size whenever it fills up; Push is O(1) amortized and Pop and
+// Steal are O(1). Only the owner may call Push and Pop, while any goroutine may call Steal and Size.
+//
+// The implementation follows "Correct and Efficient Work-Stealing for Weak Memory Models" (Lê et al., 2013), using Go's
+// sequentially consistent atomics throughout.
+type workStealingDeque[T any] struct {
+	top    atomic.Int64
+	bottom atomic.Int64
+	array  atomic.Pointer[ringArray[T]]
+}
+
+// A ringArray is a fixed size circular array of a workStealingDeque, indexed by ever increasing positions. Slots are
+// atomic so that a thief reading a slot never races with the owner writing another element to it.
+type ringArray[T any] struct {
+	slots []atomic.Pointer[T]
+	mask  int64
+}
+
+// Returns a new ring array with room for the given amount of elements, which must be a power of two.
+func newRingArray[T any](capacity int64) *ringArray[T] {
+	return &ringArray[T]{slots: make([]atomic.Pointer[T], capacity), mask: capacity - 1}
+}
+
+// Returns the element at the given position.
+func (a *ringArray[T]) get(i int64) T {
+	return *a.slots[i&a.mask].Load()
+}
+
+// Stores the element at the given position.
+func (a *ringArray[T]) put(i int64, val T) {
+	a.slots[i&a.mask].Store(&val)
+}
+
+// Returns a ring array twice the size of this one, holding the elements between the top and bottom positions.
+func (a *ringArray[T]) grow(top, bottom int64) *ringArray[T] {
+	grown := newRingArray[T](2 * int64(len(a.slots)))
+	for i := top; i < bottom; i++ {
+		grown.slots[i&grown.mask].Store(a.slots[i&a.mask].Load())
+	}
+
+	return grown
+}
+
+// Returns a new, empty work-stealing deque of the specified type.
+func NewWorkStealingDeque[T any]() *workStealingDeque[T] {
+	d := &workStealingDeque[T]{}
+	d.array.Store(newRingArray[T](minDequeCap))
+	return d
+}
+
+// Adds an element to the bottom of the deque. Only the owner of the deque may call Push.
+func (d *workStealingDeque[T]) Push(val T) {
+	b := d.bottom.Load()
+	t := d.top.Load()
+	a := d.array.Load()
+
+	if b-t >= int64(len(a.slots)) {
+		a = a.grow(t, b)
+		d.array.Store(a)
+	}
+
+	a.put(b, val)
+	d.bottom.Store(b + 1)
+}
+
+// Removes the element at the bottom of the deque - the most recently pushed - and returns it along with true, or
+// returns the zero value and false if the deque is empty. Only the owner of the deque may call Pop.
+func (d *workStealingDeque[T]) Pop() (T, bool) {
+	var zero T
+
+	b := d.bottom.Load() - 1
+	a := d.array.Load()
+	d.bottom.Store(b)
+	t := d.top.Load()
+
+	if t > b {
+		d.bottom.Store(b + 1)
+		return zero, false
+	}
+
+	val := a.get(b)
+	if t == b {
+		// The last element; a thief may be after it too.
+		won := d.top.CompareAndSwap(t, t+1)
+		d.bottom.Store(b + 1)
+		if !won {
+			return zero, false
+		}
+	}
+
+	return val, true
+}
+
+// Removes the element at the top of the deque - the least recently pushed - and returns it along with true. Returns the
+// zero value and false if the deque is empty or if another goroutine took the element first, in which case the caller
+// should look for work elsewhere or try again. Any goroutine may call Steal.
+func (d *workStealingDeque[T]) Steal() (T, bool) {
+	var zero T
+
+	t := d.top.Load()
+	b := d.bottom.Load()
+	if t >= b {
+		return zero, false
+	}
+
+	a := d.array.Load()
+	val := a.get(t)
+	if !d.top.CompareAndSwap(t, t+1) {
+		return zero, false
+	}
+
+	return val, true
+}
+
+// Returns the amount of elements in the deque. When called by a goroutine other than the owner, the result is an
+// estimate.
+func (d *workStealingDeque[T]) Size() int {
+	size := d.bottom.Load() - d.top.Load()
+	if size < 0 {
+		return 0
+	}
+
+	return int(size)
+}
+
+// Returns true if the deque holds no elements, otherwise returns false. When called by a goroutine other than the owner,
+// the result is an estimate.
+func (d *workStealingDeque[T]) IsEmpty() bool {
+	return d.Size() == 0
+}
@@ -0,0 +1,423 @@
+package cln_test
+
+import (
+	"container/heap"
+	"container/list"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// The amounts of elements each benchmark is run against.
+var benchSizes = []int{10, 1000, 10000}
+
+// A record is a larger element type, used to show how element size affects each implementation.
+type record struct {
+	id    int64
+	owner string
+	score float64
+}
+
+// A benchSubject is the subset of operations shared by the cln collections and the standard library baselines they are
+// compared against.
+type benchSubject[T comparable] interface {
+	Add(vals ...T)
+	Take() (T, bool)
+	Contains(val T) bool
+	Remove(val T) bool
+	RemoveIf(pred func(val T) bool) int
+	Each(f func(val T))
+}
+
+// A benchTarget names an implementation and creates empty instances of it.
+type benchTarget[T comparable] struct {
+	name string
+	new  func() benchSubject[T]
+}
+
+// Returns the implementations benchmarked for elements of type T. The heap baseline orders its elements with less.
+func benchTargets[T comparable](less func(a, b T) bool) []benchTarget[T] {
+	return []benchTarget[T]{
+		{"queue", func() benchSubject[T] { return collectionSubject[T]{cln.NewQueue[T]()} }},
+		{"chunkedQueue", func() benchSubject[T] { return collectionSubject[T]{cln.NewChunkedQueue[T]()} }},
+		{"pooledQueue", func() benchSubject[T] { return collectionSubject[T]{cln.NewPooledQueue[T](1024)} }},
+		{"stack", func() benchSubject[T] { return collectionSubject[T]{cln.NewStack[T]()} }},
+		{"slice", func() benchSubject[T] { return &sliceSubject[T]{} }},
+		{"list", func() benchSubject[T] { return &listSubject[T]{list.New()} }},
+		{"heap", func() benchSubject[T] { return &heapSubject[T]{less: less} }},
+	}
+}
+
+// Runs body as a sub-benchmark for every implementation and size, with elements generated by gen.
+func benchAll[T comparable](b *testing.B, gen func(i int) T, less func(a, b T) bool,
+	body func(b *testing.B, newSubject func() benchSubject[T], vals []T)) {
+	for _, size := range benchSizes {
+		vals := make([]T, size)
+		for i := range vals {
+			vals[i] = gen(i)
+		}
+
+		for _, target := range benchTargets(less) {
+			b.Run(fmt.Sprintf("%s/%d", target.name, size), func(b *testing.B) {
+				b.ReportAllocs()
+				body(b, target.new, vals)
+			})
+		}
+	}
+}
+
+// Runs body against every implementation and size for elements of type int.
+func benchInts(b *testing.B, body func(b *testing.B, newSubject func() benchSubject[int], vals []int)) {
+	b.Run("int", func(b *testing.B) {
+		benchAll(b, func(i int) int { return i }, func(a, b int) bool { return a < b }, body)
+	})
+}
+
+// Runs body against every implementation and size for elements of type string.
+func benchStrings(b *testing.B, body func(b *testing.B, newSubject func() benchSubject[string], vals []string)) {
+	b.Run("string", func(b *testing.B) {
+		benchAll(b, strconv.Itoa, func(a, b string) bool { return a < b }, body)
+	})
+}
+
+// Runs body against every implementation and size for elements of type record.
+func benchRecords(b *testing.B, body func(b *testing.B, newSubject func() benchSubject[record], vals []record)) {
+	b.Run("record", func(b *testing.B) {
+		gen := func(i int) record { return record{id: int64(i), owner: strconv.Itoa(i), score: float64(i)} }
+		benchAll(b, gen, func(a, b record) bool { return a.id < b.id }, body)
+	})
+}
+
+// Returns a new subject filled with the given values.
+func filledSubject[T comparable](newSubject func() benchSubject[T], vals []T) benchSubject[T] {
+	s := newSubject()
+	s.Add(vals...)
+	return s
+}
+
+func benchAdd[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	for i := 0; i < b.N; i++ {
+		s := newSubject()
+		for _, v := range vals {
+			s.Add(v)
+		}
+	}
+}
+
+func benchAddBatch[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	for i := 0; i < b.N; i++ {
+		s := newSubject()
+		s.Add(vals...)
+	}
+}
+
+func benchTake[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := filledSubject(newSubject, vals)
+		b.StartTimer()
+		for _, ok := s.Take(); ok; _, ok = s.Take() {
+		}
+	}
+}
+
+func benchContains[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	s := filledSubject(newSubject, vals)
+	missing := vals[len(vals)/2]
+	s.Remove(missing)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(missing)
+	}
+}
+
+func benchRemove[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	s := filledSubject(newSubject, vals)
+	middle := vals[len(vals)/2]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Remove(middle)
+		s.Add(middle)
+	}
+}
+
+func benchFilter[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		s := filledSubject(newSubject, vals)
+		remove := false
+		b.StartTimer()
+		s.RemoveIf(func(T) bool {
+			remove = !remove
+			return remove
+		})
+	}
+}
+
+func benchIter[T comparable](b *testing.B, newSubject func() benchSubject[T], vals []T) {
+	s := filledSubject(newSubject, vals)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Each(func(T) {})
+	}
+}
+
+// Benchmarks adding every element one call at a time.
+func BenchmarkAdd(b *testing.B) {
+	benchInts(b, benchAdd[int])
+	benchStrings(b, benchAdd[string])
+	benchRecords(b, benchAdd[record])
+}
+
+// Benchmarks adding every element in a single batch.
+func BenchmarkAddBatch(b *testing.B) {
+	benchInts(b, benchAddBatch[int])
+	benchStrings(b, benchAddBatch[string])
+	benchRecords(b, benchAddBatch[record])
+}
+
+// Benchmarks taking every element until the collection is empty.
+func BenchmarkTake(b *testing.B) {
+	benchInts(b, benchTake[int])
+	benchStrings(b, benchTake[string])
+	benchRecords(b, benchTake[record])
+}
+
+// Benchmarks looking up an element that is not in the collection, the worst case for a linear scan.
+func BenchmarkContains(b *testing.B) {
+	benchInts(b, benchContains[int])
+	benchStrings(b, benchContains[string])
+	benchRecords(b, benchContains[record])
+}
+
+// Benchmarks removing the middle element and adding it back, keeping the size constant.
+func BenchmarkRemove(b *testing.B) {
+	benchInts(b, benchRemove[int])
+	benchStrings(b, benchRemove[string])
+	benchRecords(b, benchRemove[record])
+}
+
+// Benchmarks removing every other element with RemoveIf.
+func BenchmarkFilter(b *testing.B) {
+	benchInts(b, benchFilter[int])
+	benchStrings(b, benchFilter[string])
+	benchRecords(b, benchFilter[record])
+}
+
+// Benchmarks visiting every element. The cln collections are iterated through Iter.
+func BenchmarkIter(b *testing.B) {
+	benchInts(b, benchIter[int])
+	benchStrings(b, benchIter[string])
+	benchRecords(b, benchIter[record])
+}
+
+// A collectionSubject adapts a cln.Collection to a benchSubject.
+type collectionSubject[T comparable] struct {
+	cln.Collection[T]
+}
+
+func (c collectionSubject[T]) Each(f func(val T)) {
+	for v := range c.Iter() {
+		f(v)
+	}
+}
+
+// A sliceSubject is a plain slice used as a FIFO queue.
+type sliceSubject[T comparable] struct {
+	vals []T
+}
+
+func (s *sliceSubject[T]) Add(vals ...T) {
+	s.vals = append(s.vals, vals...)
+}
+
+func (s *sliceSubject[T]) Take() (T, bool) {
+	if len(s.vals) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	val := s.vals[0]
+	s.vals = s.vals[1:]
+	return val, true
+}
+
+func (s *sliceSubject[T]) Contains(val T) bool {
+	for _, v := range s.vals {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *sliceSubject[T]) Remove(val T) bool {
+	for i, v := range s.vals {
+		if v == val {
+			s.vals = append(s.vals[:i], s.vals[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *sliceSubject[T]) RemoveIf(pred func(val T) bool) int {
+	kept := s.vals[:0]
+	for _, v := range s.vals {
+		if !pred(v) {
+			kept = append(kept, v)
+		}
+	}
+
+	removed := len(s.vals) - len(kept)
+	s.vals = kept
+	return removed
+}
+
+func (s *sliceSubject[T]) Each(f func(val T)) {
+	for _, v := range s.vals {
+		f(v)
+	}
+}
+
+// A listSubject is a container/list used as a FIFO queue.
+type listSubject[T comparable] struct {
+	l *list.List
+}
+
+func (s *listSubject[T]) Add(vals ...T) {
+	for _, v := range vals {
+		s.l.PushBack(v)
+	}
+}
+
+func (s *listSubject[T]) Take() (T, bool) {
+	front := s.l.Front()
+	if front == nil {
+		var zero T
+		return zero, false
+	}
+
+	return s.l.Remove(front).(T), true
+}
+
+func (s *listSubject[T]) Contains(val T) bool {
+	for e := s.l.Front(); e != nil; e = e.Next() {
+		if e.Value.(T) == val {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *listSubject[T]) Remove(val T) bool {
+	for e := s.l.Front(); e != nil; e = e.Next() {
+		if e.Value.(T) == val {
+			s.l.Remove(e)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *listSubject[T]) RemoveIf(pred func(val T) bool) int {
+	removed := 0
+	for e := s.l.Front(); e != nil; {
+		next := e.Next()
+		if pred(e.Value.(T)) {
+			s.l.Remove(e)
+			removed++
+		}
+		e = next
+	}
+
+	return removed
+}
+
+func (s *listSubject[T]) Each(f func(val T)) {
+	for e := s.l.Front(); e != nil; e = e.Next() {
+		f(e.Value.(T))
+	}
+}
+
+// A heapSubject is a container/heap backed priority queue, taking the smallest element first.
+type heapSubject[T comparable] struct {
+	vals []T
+	less func(a, b T) bool
+}
+
+func (h *heapSubject[T]) Len() int           { return len(h.vals) }
+func (h *heapSubject[T]) Less(i, j int) bool { return h.less(h.vals[i], h.vals[j]) }
+func (h *heapSubject[T]) Swap(i, j int)      { h.vals[i], h.vals[j] = h.vals[j], h.vals[i] }
+func (h *heapSubject[T]) Push(x any)         { h.vals = append(h.vals, x.(T)) }
+
+func (h *heapSubject[T]) Pop() any {
+	last := h.vals[len(h.vals)-1]
+	h.vals = h.vals[:len(h.vals)-1]
+	return last
+}
+
+func (h *heapSubject[T]) Add(vals ...T) {
+	for _, v := range vals {
+		heap.Push(h, v)
+	}
+}
+
+func (h *heapSubject[T]) Take() (T, bool) {
+	if len(h.vals) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return heap.Pop(h).(T), true
+}
+
+func (h *heapSubject[T]) Contains(val T) bool {
+	for _, v := range h.vals {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *heapSubject[T]) Remove(val T) bool {
+	for i, v := range h.vals {
+		if v == val {
+			heap.Remove(h, i)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (h *heapSubject[T]) RemoveIf(pred func(val T) bool) int {
+	kept := h.vals[:0]
+	for _, v := range h.vals {
+		if !pred(v) {
+			kept = append(kept, v)
+		}
+	}
+
+	removed := len(h.vals) - len(kept)
+	h.vals = kept
+	heap.Init(h)
+	return removed
+}
+
+func (h *heapSubject[T]) Each(f func(val T)) {
+	for _, v := range h.vals {
+		f(v)
+	}
+}
@@ -0,0 +1,193 @@
+package cln_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Returns the fraction of the given amount of elements, never added to the filter, that it might contain.
+func falsePositives(mightContain func(val int) bool, start, count int) float64 {
+	fp := 0
+	for i := start; i < start+count; i++ {
+		if mightContain(i) {
+			fp++
+		}
+	}
+	return float64(fp) / float64(count)
+}
+
+func TestBloomFilter_MightContain(t *testing.T) {
+	t.Run("MightContain Should Return True For Every Added Element", func(t *testing.T) {
+		bf := cln.NewBloomFilter[string](1000, 0.01)
+		for i := 0; i < 1000; i++ {
+			bf.Add(fmt.Sprintf("event-%d", i))
+		}
+
+		for i := 0; i < 1000; i++ {
+			if !bf.MightContain(fmt.Sprintf("event-%d", i)) {
+				t.Fatalf("False negative for event-%d", i)
+			}
+		}
+	})
+
+	t.Run("MightContain Should Return True For Negative Zero When Zero Was Added", func(t *testing.T) {
+		type reading struct {
+			sensor string
+			value  float64
+		}
+		negZero := math.Copysign(0, -1)
+
+		floats := cln.NewBloomFilter[float64](100, 0.01)
+		floats.Add(0)
+		if !floats.MightContain(negZero) {
+			t.Error("False negative for -0 after adding 0")
+		}
+
+		readings := cln.NewBloomFilter[reading](100, 0.01)
+		readings.Add(reading{"a", 0})
+		if !readings.MightContain(reading{"a", negZero}) {
+			t.Error("False negative for a struct holding -0 after adding one holding 0")
+		}
+	})
+
+	t.Run("MightContain Should Return True For Added Pointers After Their Pointees Change", func(t *testing.T) {
+		bf := cln.NewBloomFilter[*int](100, 0.01)
+		ptrs := make([]*int, 100)
+		for i := range ptrs {
+			ptrs[i] = new(int)
+			bf.Add(ptrs[i])
+		}
+
+		for i, p := range ptrs {
+			*p = i + 1
+			if !bf.MightContain(p) {
+				t.Fatalf("False negative for pointer %d after its pointee changed", i)
+			}
+		}
+	})
+
+	t.Run("MightContain Should Stay Near Target Rate When Filter Holds Expected Amount", func(t *testing.T) {
+		for _, target := range []float64{0.1, 0.01, 0.001} {
+			bf := cln.NewBloomFilter[int](10000, target)
+			bf.Add(span(0, 10000)...)
+
+			rate := falsePositives(bf.MightContain, 1_000_000, 100_000)
+			if rate > 2*target {
+				t.Errorf("False-positive rate %v is more than double the target %v", rate, target)
+			}
+			if est := bf.FalsePositiveRate(); math.Abs(est-target) > target/2 {
+				t.Errorf("FalsePositiveRate estimated %v for a target of %v", est, target)
+			}
+		}
+	})
+
+	t.Run("MightContain Should Use Given Hasher", func(t *testing.T) {
+		calls := 0
+		bf := cln.NewBloomFilterWithHasher[int](100, 0.01, func(val int) uint64 {
+			calls++
+			return uint64(val) * 0x9e3779b97f4a7c15
+		})
+		bf.Add(1)
+
+		if !bf.MightContain(1) || calls != 2 {
+			t.Errorf("Expected the hasher to be called twice but it was called %d times", calls)
+		}
+	})
+}
+
+func TestBloomFilter_EstimatedCount(t *testing.T) {
+	t.Run("EstimatedCount Should Be Within Five Percent of Distinct Elements Added", func(t *testing.T) {
+		bf := cln.NewBloomFilter[int](20000, 0.01)
+		for i := 0; i < 3; i++ {
+			bf.Add(span(0, 10000)...)
+		}
+
+		if est := bf.EstimatedCount(); math.Abs(float64(est-10000)) > 500 {
+			t.Errorf("Estimated %d distinct elements but 10000 were added", est)
+		}
+	})
+}
+
+func TestBloomFilter_Union(t *testing.T) {
+	t.Run("Union Should Contain Elements of Both Filters", func(t *testing.T) {
+		a := cln.NewBloomFilter[int](1000, 0.01)
+		b := cln.NewBloomFilter[int](1000, 0.01)
+		a.Add(span(0, 500)...)
+		b.Add(span(500, 1000)...)
+
+		if err := a.Union(b); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 1000; i++ {
+			if !a.MightContain(i) {
+				t.Fatalf("False negative for %d", i)
+			}
+		}
+	})
+
+	t.Run("Union Should Fail When Filters Differ in Size", func(t *testing.T) {
+		a := cln.NewBloomFilter[int](1000, 0.01)
+		b := cln.NewBloomFilter[int](2000, 0.01)
+
+		if err := a.Union(b); err == nil {
+			t.Error("Union accepted filters of different sizes!")
+		}
+	})
+}
+
+func TestBloomFilter_MarshalBinary(t *testing.T) {
+	t.Run("UnmarshalBinary Should Restore Filter", func(t *testing.T) {
+		bf := cln.NewBloomFilter[string](500, 0.01)
+		bf.Add("a", "b", "c")
+		data, err := bf.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		restored := cln.NewBloomFilter[string](1, 0.5)
+		if err := restored.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		if !restored.MightContain("a") || !restored.MightContain("b") || !restored.MightContain("c") {
+			t.Error("Restored filter lost elements")
+		}
+		if restored.EstimatedCount() != bf.EstimatedCount() {
+			t.Errorf("Restored filter estimates %d elements but the original estimates %d", restored.EstimatedCount(),
+				bf.EstimatedCount())
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Truncated Data", func(t *testing.T) {
+		data, _ := cln.NewBloomFilter[int](500, 0.01).MarshalBinary()
+
+		if err := cln.NewBloomFilter[int](1, 0.5).UnmarshalBinary(data[:len(data)-1]); err == nil {
+			t.Error("UnmarshalBinary accepted truncated data!")
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Data Claiming Too Many Hashes", func(t *testing.T) {
+		data, _ := cln.NewBloomFilter[int](500, 0.01).MarshalBinary()
+		binary.LittleEndian.PutUint32(data[5:], math.MaxUint32)
+
+		if err := cln.NewBloomFilter[int](1, 0.5).UnmarshalBinary(data); err == nil {
+			t.Error("UnmarshalBinary accepted a filter of 2^32-1 hashes!")
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Data Claiming Too Many Bits", func(t *testing.T) {
+		data, _ := cln.NewBloomFilter[int](500, 0.01).MarshalBinary()
+		data = data[:17]
+		binary.LittleEndian.PutUint64(data[9:], math.MaxUint64)
+
+		bf := cln.NewBloomFilter[int](1, 0.5)
+		if err := bf.UnmarshalBinary(data); err == nil {
+			t.Error("UnmarshalBinary accepted a filter of 2^64-1 bits held by no bytes!")
+		}
+		bf.MightContain(1)
+	})
+}
@@ -0,0 +1,153 @@
+package cln_test
+
+import (
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+	"github.com/SMTanami/collections/clntest"
+)
+
+// Returns the values from start up to, but not including, end.
+func span(start, end int) []int {
+	vals := make([]int, 0, end-start)
+	for i := start; i < end; i++ {
+		vals = append(vals, i)
+	}
+
+	return vals
+}
+
+func TestChunkedQueue_Add(t *testing.T) {
+	t.Run("Add Should Maintain Order Across Several Chunks", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		exp := span(0, 300)
+
+		q.Add(exp[:100]...)
+		for _, v := range exp[100:] {
+			q.Add(v)
+		}
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestChunkedQueue_Take(t *testing.T) {
+	t.Run("Take Should Return Elements in Order Across Several Chunks", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		q.Add(span(0, 300)...)
+
+		for i := 0; i < 300; i++ {
+			val, ok := q.Take()
+			if !ok || val != i {
+				t.Fatalf("Take returned (%d, %t) but expected (%d, true)", val, ok, i)
+			}
+		}
+
+		if _, ok := q.Take(); ok {
+			t.Error("Take returned true on drained queue!")
+		}
+	})
+
+	t.Run("Take Should Maintain Order When Interleaved With Add", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		exp := span(150, 250)
+
+		q.Add(span(0, 200)...)
+		for i := 0; i < 150; i++ {
+			q.Take()
+		}
+		q.Add(span(200, 250)...)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestChunkedQueue_Peek(t *testing.T) {
+	t.Run("Peek Should Return Head When Head Moves to Next Chunk", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		q.Add(span(0, 100)...)
+
+		for i := 0; i < 64; i++ {
+			q.Take()
+		}
+
+		val, ok := q.Peek()
+		if !ok || val != 64 {
+			t.Errorf("Peek returned (%d, %t) but expected (64, true)", val, ok)
+		}
+	})
+}
+
+func TestChunkedQueue_RemoveIf(t *testing.T) {
+	t.Run("RemoveIf Should Compact Elements Across Several Chunks", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		q.Add(span(0, 300)...)
+		q.Take()
+		var exp []int
+		for i := 1; i < 300; i++ {
+			if i%3 != 0 {
+				exp = append(exp, i)
+			}
+		}
+
+		removed := q.RemoveIf(func(v int) bool {
+			return v%3 == 0
+		})
+
+		if removed != 99 {
+			t.Errorf("RemoveIf returned %d but expected 99!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestChunkedQueue_String(t *testing.T) {
+	t.Run("String Should Match Queue String", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		q.Add(1, 2, 3)
+		expectedString := "1 -> 2 -> 3"
+
+		actualString := q.String()
+		if actualString != expectedString {
+			t.Errorf("\nExpected: %s\nGot: %s", expectedString, actualString)
+		}
+	})
+}
+
+func TestChunkedQueue_Conformance(t *testing.T) {
+	clntest.Run(t, func() cln.Collection[int] {
+		return cln.NewChunkedQueue[int]()
+	}, clntest.FIFO)
+}
+
+func TestChunkedQueue_Model(t *testing.T) {
+	clntest.RunModel(t, func() cln.Collection[int] {
+		return cln.NewChunkedQueue[int]()
+	}, clntest.FIFO, clntest.ModelConfig{MaxOps: 512})
+}
+
+func FuzzChunkedQueue(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 6, 12, 1, 2, 3})
+	f.Add([]byte{0, 0, 6, 3, 4, 5, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		clntest.RunBytes(t, func() cln.Collection[int] {
+			return cln.NewChunkedQueue[int]()
+		}, clntest.FIFO, data)
+	})
+}
@@ -0,0 +1,324 @@
+package cln_test
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+func TestConcurrentMap_Load(t *testing.T) {
+	t.Run("Load Should Return False When Key is Missing", func(t *testing.T) {
+		m := cln.NewConcurrentMap[string, int]()
+
+		val, ok := m.Load("missing")
+		if ok || val != 0 {
+			t.Errorf("Load returned (%d, %t) but expected (0, false)", val, ok)
+		}
+	})
+
+	t.Run("Load Should Return Stored Value", func(t *testing.T) {
+		m := cln.NewConcurrentMap[string, int]()
+		m.Store("a", 1)
+		m.Store("a", 2)
+
+		val, ok := m.Load("a")
+		if !ok || val != 2 {
+			t.Errorf("Load returned (%d, %t) but expected (2, true)", val, ok)
+		}
+	})
+}
+
+func TestConcurrentMap_LoadOrStore(t *testing.T) {
+	t.Run("LoadOrStore Should Store Only When Key is Missing", func(t *testing.T) {
+		m := cln.NewConcurrentMap[int, string]()
+
+		actual, loaded := m.LoadOrStore(1, "first")
+		if loaded || actual != "first" {
+			t.Errorf("LoadOrStore returned (%s, %t) but expected (first, false)", actual, loaded)
+		}
+
+		actual, loaded = m.LoadOrStore(1, "second")
+		if !loaded || actual != "first" {
+			t.Errorf("LoadOrStore returned (%s, %t) but expected (first, true)", actual, loaded)
+		}
+	})
+}
+
+func TestConcurrentMap_Compute(t *testing.T) {
+	t.Run("Compute Should Count Atomically Across Goroutines", func(t *testing.T) {
+		m := cln.NewConcurrentMap[string, int]()
+		increment := func(old int, _ bool) (int, bool) { return old + 1, true }
+		var wg sync.WaitGroup
+
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					m.Compute("hits", increment)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if val, _ := m.Load("hits"); val != 8000 {
+			t.Errorf("Compute counted %d but expected 8000!", val)
+		}
+	})
+
+	t.Run("Compute Should Delete Key When Keep is False", func(t *testing.T) {
+		m := cln.NewConcurrentMap[string, int]()
+		m.Store("a", 1)
+
+		m.Compute("a", func(int, bool) (int, bool) { return 0, false })
+
+		if _, ok := m.Load("a"); ok {
+			t.Error("Compute did not delete key!")
+		}
+	})
+}
+
+func TestConcurrentMap_Delete(t *testing.T) {
+	t.Run("Delete Should Remove Key", func(t *testing.T) {
+		m := cln.NewConcurrentMap[int, int]()
+		m.Store(1, 1)
+		m.Store(2, 2)
+
+		m.Delete(1)
+		m.Delete(3)
+
+		if _, ok := m.Load(1); ok {
+			t.Error("Delete did not remove key!")
+		}
+		if m.Size() != 1 {
+			t.Errorf("Size is %d but expected 1!", m.Size())
+		}
+	})
+}
+
+func TestConcurrentMap_Range(t *testing.T) {
+	t.Run("Range Should Visit Every Key Once", func(t *testing.T) {
+		m := cln.NewConcurrentMap[int, int]()
+		for i := 0; i < 1000; i++ {
+			m.Store(i, i*2)
+		}
+
+		seen := make(map[int]bool)
+		m.Range(func(key, val int) bool {
+			if seen[key] || val != key*2 {
+				t.Errorf("Range visited key %d with value %d (seen before: %t)", key, val, seen[key])
+			}
+			seen[key] = true
+			return true
+		})
+
+		if len(seen) != 1000 {
+			t.Errorf("Range visited %d keys but expected 1000!", len(seen))
+		}
+	})
+
+	t.Run("Range Should Stop When F Returns False and Allow Writes From F", func(t *testing.T) {
+		m := cln.NewConcurrentMap[int, int]()
+		for i := 0; i < 100; i++ {
+			m.Store(i, i)
+		}
+
+		visited := 0
+		m.Range(func(key, _ int) bool {
+			m.Delete(key)
+			visited++
+			return visited < 10
+		})
+
+		if visited != 10 || m.Size() != 90 {
+			t.Errorf("Range visited %d keys leaving %d but expected 10 and 90!", visited, m.Size())
+		}
+	})
+}
+
+func TestConcurrentMap_Hasher(t *testing.T) {
+	t.Run("Custom Hasher Should Be Used for Custom Key Types", func(t *testing.T) {
+		type point struct{ x, y int }
+		m := cln.NewConcurrentMapWithHasher[point, string](5, func(p point) uint64 {
+			return uint64(p.x*31 + p.y)
+		})
+
+		m.Store(point{1, 2}, "a")
+		m.Store(point{2, 1}, "b")
+
+		if val, _ := m.Load(point{1, 2}); val != "a" {
+			t.Errorf("Load returned %q but expected \"a\"", val)
+		}
+		if m.Size() != 2 {
+			t.Errorf("Size is %d but expected 2!", m.Size())
+		}
+	})
+
+	t.Run("Default Hasher Should Handle Struct Keys", func(t *testing.T) {
+		type point struct{ x, y int }
+		m := cln.NewConcurrentMap[point, int]()
+
+		for i := 0; i < 100; i++ {
+			m.Store(point{i, -i}, i)
+		}
+
+		for i := 0; i < 100; i++ {
+			if val, ok := m.Load(point{i, -i}); !ok || val != i {
+				t.Fatalf("Load returned (%d, %t) but expected (%d, true)", val, ok, i)
+			}
+		}
+	})
+
+	t.Run("Default Hasher Should Hash Pointer Keys by Address", func(t *testing.T) {
+		m := cln.NewConcurrentMap[*int, int]()
+		keys := make([]*int, 100)
+		for i := range keys {
+			keys[i] = new(int)
+			m.Store(keys[i], i)
+		}
+
+		for i, p := range keys {
+			*p = i + 1000
+			if val, ok := m.Load(p); !ok || val != i {
+				t.Fatalf("Load returned (%d, %t) after the pointee changed but expected (%d, true)", val, ok, i)
+			}
+		}
+	})
+
+	t.Run("Default Hasher Should Treat Negative Zero as Zero", func(t *testing.T) {
+		type sample struct {
+			at    float64
+			phase complex128
+		}
+		negZero := math.Copysign(0, -1)
+
+		floats := cln.NewConcurrentMap[float64, string]()
+		floats.Store(0, "zero")
+		if val, ok := floats.Load(negZero); !ok || val != "zero" {
+			t.Errorf("Load(-0) returned (%q, %t) but expected (\"zero\", true)", val, ok)
+		}
+
+		samples := cln.NewConcurrentMap[sample, string]()
+		samples.Store(sample{0, 0}, "zero")
+		if val, ok := samples.Load(sample{negZero, complex(negZero, negZero)}); !ok || val != "zero" {
+			t.Errorf("Load of a struct holding -0 returned (%q, %t) but expected (\"zero\", true)", val, ok)
+		}
+	})
+
+	t.Run("Default Hasher Should Tell Apart Struct Keys Whose Strings Concatenate Equally", func(t *testing.T) {
+		type pair struct{ a, b string }
+		m := cln.NewConcurrentMap[pair, int]()
+		keys := []pair{{"ab", ""}, {"a", "b"}, {"", "ab"}}
+		for i, key := range keys {
+			m.Store(key, i)
+		}
+
+		if m.Size() != len(keys) {
+			t.Fatalf("Size is %d but expected %d!", m.Size(), len(keys))
+		}
+		for i, key := range keys {
+			if val, ok := m.Load(key); !ok || val != i {
+				t.Errorf("Load(%v) returned (%d, %t) but expected (%d, true)", key, val, ok, i)
+			}
+		}
+	})
+}
+
+// A benchMap is the subset of operations shared by the maps compared in the concurrent map benchmarks.
+type benchMap interface {
+	Load(key string) (int, bool)
+	Store(key string, val int)
+}
+
+// A mutexMap is a plain map guarded by a single sync.RWMutex.
+type mutexMap struct {
+	mu sync.RWMutex
+	m  map[string]int
+}
+
+func (m *mutexMap) Load(key string) (int, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	val, ok := m.m[key]
+	return val, ok
+}
+
+func (m *mutexMap) Store(key string, val int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m[key] = val
+}
+
+// A syncMap adapts sync.Map to a benchMap.
+type syncMap struct {
+	m sync.Map
+}
+
+func (m *syncMap) Load(key string) (int, bool) {
+	val, ok := m.m.Load(key)
+	if !ok {
+		return 0, false
+	}
+
+	return val.(int), true
+}
+
+func (m *syncMap) Store(key string, val int) {
+	m.m.Store(key, val)
+}
+
+// Runs a parallel workload against every map where writePercent of the operations are stores and the rest are loads.
+func benchMaps(b *testing.B, writePercent int) {
+	keys := make([]string, 4096)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	maps := []struct {
+		name string
+		new  func() benchMap
+	}{
+		{"concurrentMap", func() benchMap { return cln.NewConcurrentMap[string, int]() }},
+		{"syncMap", func() benchMap { return &syncMap{} }},
+		{"mutexMap", func() benchMap { return &mutexMap{m: make(map[string]int)} }},
+	}
+
+	for _, bm := range maps {
+		b.Run(bm.name, func(b *testing.B) {
+			m := bm.new()
+			for i, k := range keys {
+				m.Store(k, i)
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					k := keys[i%len(keys)]
+					if i%100 < writePercent {
+						m.Store(k, i)
+					} else {
+						m.Load(k)
+					}
+					i++
+				}
+			})
+		})
+	}
+}
+
+// Benchmarks a workload of 90% loads and 10% stores.
+func BenchmarkConcurrentMap_ReadHeavy(b *testing.B) {
+	benchMaps(b, 10)
+}
+
+// Benchmarks a workload of 10% loads and 90% stores.
+func BenchmarkConcurrentMap_WriteHeavy(b *testing.B) {
+	benchMaps(b, 90)
+}
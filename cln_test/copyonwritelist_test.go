@@ -0,0 +1,97 @@
+package cln_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+	"github.com/SMTanami/collections/clntest"
+)
+
+func TestCopyOnWriteList_Iter(t *testing.T) {
+	t.Run("Iter Should Yield Snapshot When List is Modified While Iterating", func(t *testing.T) {
+		l := cln.NewCopyOnWriteList[int]()
+		l.Add(1, 2, 3)
+
+		var got []int
+		for v := range l.Iter() {
+			got = append(got, v)
+			l.Remove(v)
+			l.Add(v * 10)
+		}
+
+		valid, msg := ValidateCollection[int]([]int{10, 20, 30}, l)
+		if !valid {
+			t.Error(msg)
+		}
+		if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+			t.Errorf("Iter did not yield the snapshot!\nExpected: [1 2 3]\nGot: %v", got)
+		}
+	})
+}
+
+func TestCopyOnWriteList_Add(t *testing.T) {
+	t.Run("Add Should Keep Every Element When Readers and Writers Run Concurrently", func(t *testing.T) {
+		l := cln.NewCopyOnWriteList[int]()
+		var wg sync.WaitGroup
+
+		for g := 0; g < 4; g++ {
+			wg.Add(2)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					l.Add(g*100 + i)
+				}
+			}(g)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					l.Contains(i)
+					for range l.Iter() {
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		if l.Size() != 400 {
+			t.Errorf("Size is %d but expected 400!", l.Size())
+		}
+	})
+}
+
+func TestCopyOnWriteList_String(t *testing.T) {
+	t.Run("String Should Return Bracketed Elements", func(t *testing.T) {
+		l := cln.NewCopyOnWriteList[int]()
+		l.Add(1, 2, 3)
+		expectedString := "[1 2 3]"
+
+		actualString := l.String()
+		if actualString != expectedString {
+			t.Errorf("\nExpected: %s\nGot: %s", expectedString, actualString)
+		}
+	})
+}
+
+func TestCopyOnWriteList_Conformance(t *testing.T) {
+	clntest.Run(t, func() cln.Collection[int] {
+		return cln.NewCopyOnWriteList[int]()
+	}, clntest.FIFO)
+}
+
+func TestCopyOnWriteList_Model(t *testing.T) {
+	clntest.RunModel(t, func() cln.Collection[int] {
+		return cln.NewCopyOnWriteList[int]()
+	}, clntest.FIFO, clntest.ModelConfig{})
+}
+
+func FuzzCopyOnWriteList(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 6, 12, 1, 2, 3})
+	f.Add([]byte{0, 0, 6, 3, 4, 5, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		clntest.RunBytes(t, func() cln.Collection[int] {
+			return cln.NewCopyOnWriteList[int]()
+		}, clntest.FIFO, data)
+	})
+}
@@ -0,0 +1,96 @@
+package cln_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Returns a stream of endpoint names whose frequencies follow a Zipf distribution, along with their exact counts.
+func zipfStream(seed int64, length int) ([]string, map[string]uint64) {
+	rng := rand.New(rand.NewSource(seed))
+	zipf := rand.NewZipf(rng, 1.2, 1, 100000)
+
+	stream := make([]string, length)
+	exact := make(map[string]uint64)
+	for i := range stream {
+		stream[i] = fmt.Sprintf("/api/v1/endpoint/%d", zipf.Uint64())
+		exact[stream[i]]++
+	}
+
+	return stream, exact
+}
+
+func TestCountMinSketch_Estimate(t *testing.T) {
+	t.Run("Estimate Should Never Be Less Than Exact Count", func(t *testing.T) {
+		s := cln.NewCountMinSketch[string](0.001, 0.01)
+		stream, exact := zipfStream(1, 200000)
+		for _, endpoint := range stream {
+			s.Increment(endpoint)
+		}
+
+		for endpoint, count := range exact {
+			if est := s.Estimate(endpoint); est < count {
+				t.Fatalf("Estimated %d occurrences of %s but there are %d", est, endpoint, count)
+			}
+		}
+	})
+
+	t.Run("Estimate Should Be Within Epsilon of Total For Nearly Every Element", func(t *testing.T) {
+		epsilon, delta := 0.001, 0.01
+		s := cln.NewCountMinSketch[string](epsilon, delta)
+		stream, exact := zipfStream(2, 200000)
+		for _, endpoint := range stream {
+			s.Increment(endpoint)
+		}
+
+		bound := uint64(epsilon * float64(s.Total()))
+		over := 0
+		for endpoint, count := range exact {
+			if s.Estimate(endpoint)-count > bound {
+				over++
+			}
+		}
+		if rate := float64(over) / float64(len(exact)); rate > delta {
+			t.Errorf("%.2f%% of estimates are off by more than %d", 100*rate, bound)
+		}
+	})
+
+	t.Run("Estimate Should Return Zero When Element Was Never Counted", func(t *testing.T) {
+		s := cln.NewCountMinSketch[int](0.01, 0.01)
+		s.IncrementBy(1, 5)
+
+		if est := s.Estimate(2); est != 0 {
+			t.Errorf("Expected 0 but got %d", est)
+		}
+		if est := s.Estimate(1); est != 5 {
+			t.Errorf("Expected 5 but got %d", est)
+		}
+	})
+}
+
+func TestCountMinSketch_Merge(t *testing.T) {
+	t.Run("Merge Should Sum Counts of Both Sketches", func(t *testing.T) {
+		a := cln.NewCountMinSketch[string](0.01, 0.01)
+		b := cln.NewCountMinSketch[string](0.01, 0.01)
+		a.IncrementBy("/login", 3)
+		b.IncrementBy("/login", 4)
+		b.Increment("/logout")
+
+		if err := a.Merge(b); err != nil {
+			t.Fatal(err)
+		}
+		if a.Estimate("/login") != 7 || a.Estimate("/logout") != 1 || a.Total() != 8 {
+			t.Errorf("Unexpected merged estimates %d, %d and total %d", a.Estimate("/login"), a.Estimate("/logout"),
+				a.Total())
+		}
+	})
+
+	t.Run("Merge Should Fail When Sketches Differ in Size", func(t *testing.T) {
+		if err := cln.NewCountMinSketch[int](0.01, 0.01).Merge(cln.NewCountMinSketch[int](0.001, 0.01)); err == nil {
+			t.Error("Merge accepted sketches of different sizes!")
+		}
+	})
+}
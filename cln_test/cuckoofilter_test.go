@@ -0,0 +1,152 @@
+package cln_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Both filters must be usable wherever an ApproxSet is expected.
+var (
+	_ cln.ApproxSet[string] = cln.NewBloomFilter[string](1, 0.5)
+	_ cln.ApproxSet[string] = cln.NewCuckooFilter[string](1)
+)
+
+func TestCuckooFilter_Contains(t *testing.T) {
+	t.Run("Contains Should Return True For Every Added Element", func(t *testing.T) {
+		cf := cln.NewCuckooFilter[string](10000)
+		for i := 0; i < 10000; i++ {
+			if err := cf.Add(fmt.Sprintf("session-%d", i)); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		for i := 0; i < 10000; i++ {
+			if !cf.Contains(fmt.Sprintf("session-%d", i)) {
+				t.Fatalf("False negative for session-%d", i)
+			}
+		}
+	})
+
+	t.Run("Contains Should Rarely Return True For Elements Never Added", func(t *testing.T) {
+		cf := cln.NewCuckooFilter[int](10000)
+		cf.Add(span(0, 10000)...)
+
+		if rate := falsePositives(cf.Contains, 1_000_000, 100_000); rate > 0.001 {
+			t.Errorf("False-positive rate %v is over 0.1%%", rate)
+		}
+	})
+}
+
+func TestCuckooFilter_Delete(t *testing.T) {
+	t.Run("Delete Should Remove Element When Element Was Added", func(t *testing.T) {
+		cf := cln.NewCuckooFilter[int](1000)
+		cf.Add(span(0, 1000)...)
+
+		for i := 0; i < 1000; i += 2 {
+			if !cf.Delete(i) {
+				t.Fatalf("Delete did not find %d", i)
+			}
+		}
+
+		for i := 0; i < 1000; i++ {
+			if i%2 == 1 && !cf.Contains(i) {
+				t.Fatalf("False negative for %d after deleting other elements", i)
+			}
+		}
+		if rate := falsePositives(cf.Contains, 0, 1000); rate > 0.51 {
+			t.Errorf("%v of the elements are still contained after deleting half", rate)
+		}
+		if cf.EstimatedCount() != 500 {
+			t.Errorf("Expected 500 elements but got %d", cf.EstimatedCount())
+		}
+	})
+
+	t.Run("Delete Should Remove One Instance When Element Was Added Twice", func(t *testing.T) {
+		cf := cln.NewCuckooFilter[string](10)
+		cf.Add("a", "a")
+
+		cf.Delete("a")
+		if !cf.Contains("a") {
+			t.Error("Deleting once removed both instances")
+		}
+		cf.Delete("a")
+		if cf.Contains("a") {
+			t.Error("Deleting twice did not remove both instances")
+		}
+	})
+}
+
+func TestCuckooFilter_Add(t *testing.T) {
+	t.Run("Add Should Return ErrFilterFull And Keep Added Elements When Filter is Full", func(t *testing.T) {
+		cf := cln.NewCuckooFilter[int](1000)
+
+		added := 0
+		for ; ; added++ {
+			if err := cf.Add(added); err != nil {
+				if err != cln.ErrFilterFull {
+					t.Fatal(err)
+				}
+				break
+			}
+		}
+
+		if lf := cf.LoadFactor(); lf < 0.9 {
+			t.Errorf("Filter filled up at a load factor of %v", lf)
+		}
+		if added < 1000 {
+			t.Errorf("Filter sized for 1000 elements filled up after %d", added)
+		}
+		for i := 0; i < added; i++ {
+			if !cf.Contains(i) {
+				t.Fatalf("False negative for %d after the filter filled up", i)
+			}
+		}
+		if cf.EstimatedCount() != added {
+			t.Errorf("Expected %d elements but got %d", added, cf.EstimatedCount())
+		}
+	})
+}
+
+func TestCuckooFilter_MarshalBinary(t *testing.T) {
+	t.Run("UnmarshalBinary Should Restore Filter", func(t *testing.T) {
+		cf := cln.NewCuckooFilter[int](100)
+		cf.Add(span(0, 50)...)
+		data, _ := cf.MarshalBinary()
+
+		restored := cln.NewCuckooFilter[int](1)
+		if err := restored.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		for i := 0; i < 50; i++ {
+			if !restored.Contains(i) {
+				t.Fatalf("Restored filter lost %d", i)
+			}
+		}
+		if restored.EstimatedCount() != 50 || restored.Capacity() != cf.Capacity() {
+			t.Errorf("Restored filter holds %d of %d slots but the original holds %d of %d", restored.EstimatedCount(),
+				restored.Capacity(), cf.EstimatedCount(), cf.Capacity())
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Bloom Filter Encoding", func(t *testing.T) {
+		data, _ := cln.NewBloomFilter[int](10, 0.1).MarshalBinary()
+
+		if err := cln.NewCuckooFilter[int](1).UnmarshalBinary(data); err == nil {
+			t.Error("UnmarshalBinary accepted a bloom filter!")
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Data Claiming Too Many Buckets", func(t *testing.T) {
+		data, _ := cln.NewCuckooFilter[int](10).MarshalBinary()
+		data = data[:21]
+		binary.LittleEndian.PutUint64(data[13:], 1<<61)
+
+		if err := cln.NewCuckooFilter[int](1).UnmarshalBinary(data); err == nil {
+			t.Error("UnmarshalBinary accepted a filter of 2^61 buckets held by no bytes!")
+		}
+	})
+}
@@ -0,0 +1,274 @@
+package cln_test
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/SMTanami/collections/cln/durable"
+)
+
+// Takes every element of the given durable queue, failing the test on any error.
+func takeAll[T any](t *testing.T, q interface {
+	Take() (T, bool, error)
+}) []T {
+	t.Helper()
+
+	var got []T
+	for {
+		v, ok, err := q.Take()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			return got
+		}
+		got = append(got, v)
+	}
+}
+
+// Fails the test unless got holds the same elements as exp, in the same order.
+func expectInts(t *testing.T, exp, got []int) {
+	t.Helper()
+
+	if len(exp) != len(got) {
+		t.Fatalf("Expected %d elements but got %d!\nExpected: %v\nGot: %v", len(exp), len(got), exp, got)
+	}
+	for i := range exp {
+		if exp[i] != got[i] {
+			t.Fatalf("Element %d differs!\nExpected: %v\nGot: %v", i, exp, got)
+		}
+	}
+}
+
+// Returns the amount of segment files in the given directory.
+func segmentCount(t *testing.T, dir string) int {
+	t.Helper()
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.seg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(matches)
+}
+
+func TestDurableQueue_Open(t *testing.T) {
+	t.Run("Open Should Recover Elements Not Taken When Queue Was Closed", func(t *testing.T) {
+		dir := t.TempDir()
+		q, err := durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := q.Add(span(0, 10)...); err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			q.Take()
+		}
+		if err := q.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		q, err = durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer q.Close()
+
+		if q.Size() != 7 {
+			t.Errorf("Expected size 7 but got %d", q.Size())
+		}
+		expectInts(t, span(3, 10), takeAll[int](t, q))
+	})
+
+	t.Run("Open Should Recover Exactly the Elements Not Taken When Queue Was Not Closed", func(t *testing.T) {
+		dir := t.TempDir()
+		q, _ := durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		q.Add(span(0, 10)...)
+		for i := 0; i < 4; i++ {
+			q.Take()
+		}
+
+		// Simulate a crash by abandoning the queue without closing it.
+		recovered, err := durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer recovered.Close()
+
+		expectInts(t, span(4, 10), takeAll[int](t, recovered))
+	})
+
+	t.Run("Open Should Redeliver Elements Taken Since Last Checkpoint When Queue Was Not Closed", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := durable.Options{CheckpointEvery: 5}
+		q, _ := durable.Open(dir, durable.GobCodec[int](), opts)
+		q.Add(span(0, 10)...)
+		for i := 0; i < 7; i++ {
+			q.Take()
+		}
+
+		recovered, err := durable.Open(dir, durable.GobCodec[int](), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer recovered.Close()
+
+		expectInts(t, span(5, 10), takeAll[int](t, recovered))
+	})
+
+	t.Run("Open Should Discard Torn Record at End of Log", func(t *testing.T) {
+		dir := t.TempDir()
+		q, _ := durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		q.Add(1, 2, 3)
+		q.Close()
+
+		// Simulate a crash part way through appending a record.
+		matches, _ := filepath.Glob(filepath.Join(dir, "*.seg"))
+		f, err := os.OpenFile(matches[len(matches)-1], os.O_WRONLY|os.O_APPEND, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.Write([]byte{40, 0, 0, 0, 1, 2})
+		f.Close()
+
+		q, err = durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		q.Add(4)
+		q.Close()
+
+		q, err = durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer q.Close()
+
+		expectInts(t, []int{1, 2, 3, 4}, takeAll[int](t, q))
+	})
+
+	t.Run("Open Should Fail When Checkpoint is Corrupt", func(t *testing.T) {
+		dir := t.TempDir()
+		q, _ := durable.Open(dir, durable.GobCodec[int](), durable.Options{})
+		q.Add(1, 2)
+		q.Take()
+		q.Close()
+
+		os.WriteFile(filepath.Join(dir, "checkpoint"), []byte("garbage"), 0o644)
+
+		if _, err := durable.Open(dir, durable.GobCodec[int](), durable.Options{}); err == nil {
+			t.Error("Open accepted a corrupt checkpoint!")
+		}
+	})
+}
+
+func TestDurableQueue_Checkpoint(t *testing.T) {
+	t.Run("Checkpoint Should Delete Segments Whose Elements Were All Taken", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := durable.Options{SegmentSize: 64, CheckpointEvery: 1000}
+		q, _ := durable.Open(dir, durable.GobCodec[int](), opts)
+		for i := 0; i < 100; i++ {
+			q.Add(i)
+		}
+		before := segmentCount(t, dir)
+
+		for i := 0; i < 90; i++ {
+			q.Take()
+		}
+		if segmentCount(t, dir) != before {
+			t.Fatal("Segments were deleted before a checkpoint was written!")
+		}
+		if err := q.Checkpoint(); err != nil {
+			t.Fatal(err)
+		}
+
+		after := segmentCount(t, dir)
+		if after >= before || after == 0 {
+			t.Errorf("Expected fewer than %d segments to remain but %d remain", before, after)
+		}
+
+		q.Close()
+		q, _ = durable.Open(dir, durable.GobCodec[int](), opts)
+		defer q.Close()
+
+		expectInts(t, span(90, 100), takeAll[int](t, q))
+	})
+}
+
+func TestDurableQueue_Add(t *testing.T) {
+	t.Run("Add Should Store Structs When Using JSONCodec", func(t *testing.T) {
+		type job struct {
+			ID   int
+			Name string
+		}
+
+		dir := t.TempDir()
+		q, _ := durable.Open(dir, durable.JSONCodec[job](), durable.Options{})
+		q.Add(job{1, "resize"}, job{2, "upload"})
+		q.Close()
+
+		q, _ = durable.Open(dir, durable.JSONCodec[job](), durable.Options{})
+		defer q.Close()
+
+		got := takeAll[job](t, q)
+		if len(got) != 2 || got[0] != (job{1, "resize"}) || got[1] != (job{2, "upload"}) {
+			t.Errorf("Unexpected jobs: %v", got)
+		}
+	})
+
+	t.Run("Add Should Return ErrClosed When Queue is Closed", func(t *testing.T) {
+		q, _ := durable.Open(t.TempDir(), durable.GobCodec[int](), durable.Options{})
+		q.Close()
+
+		if err := q.Add(1); err != durable.ErrClosed {
+			t.Errorf("Expected ErrClosed but got %v", err)
+		}
+		if _, _, err := q.Take(); err != durable.ErrClosed {
+			t.Errorf("Expected ErrClosed but got %v", err)
+		}
+	})
+
+	t.Run("Add Should Keep Every Element When Producers and Consumers Run Concurrently", func(t *testing.T) {
+		q, _ := durable.Open(t.TempDir(), durable.GobCodec[int](), durable.Options{SegmentSize: 256, NoSync: true})
+		defer q.Close()
+
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 250; i++ {
+					q.Add(g*250 + i)
+				}
+			}(g)
+		}
+
+		seen := make([]bool, 1000)
+		var mu sync.Mutex
+		for g := 0; g < 2; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 300; i++ {
+					if v, ok, err := q.Take(); err == nil && ok {
+						mu.Lock()
+						seen[v] = true
+						mu.Unlock()
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		for _, v := range takeAll[int](t, q) {
+			seen[v] = true
+		}
+		for i, ok := range seen {
+			if !ok {
+				t.Fatalf("Element %d was lost", i)
+			}
+		}
+	})
+}
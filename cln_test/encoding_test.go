@@ -0,0 +1,163 @@
+package cln_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"io"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// A binaryCollection is a collection with every binary encoding method.
+type binaryCollection interface {
+	cln.Collection[int]
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	gob.GobEncoder
+	gob.GobDecoder
+	EncodeTo(w io.Writer) error
+	DecodeFrom(r io.Reader) error
+}
+
+// The collections whose binary encoding is tested, each created by its constructor.
+var binaryCollections = []struct {
+	name string
+	new  func() binaryCollection
+}{
+	{"Queue", func() binaryCollection { return cln.NewQueue[int]() }},
+	{"ChunkedQueue", func() binaryCollection { return cln.NewChunkedQueue[int]() }},
+	{"Stack", func() binaryCollection { return cln.NewStack[int]() }},
+	{"CopyOnWriteList", func() binaryCollection { return cln.NewCopyOnWriteList[int]() }},
+	{"Synchronized", func() binaryCollection { return cln.Synchronized[int](cln.NewQueue[int]()) }},
+}
+
+func TestBinary_Marshal(t *testing.T) {
+	for _, bc := range binaryCollections {
+		t.Run(bc.name+" Should Restore Order on Round Trip", func(t *testing.T) {
+			c := bc.new()
+			c.Add(5, 0, 9, 4, 0)
+			data, err := c.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decoded := bc.new()
+			decoded.Add(100)
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+
+			valid, msg := ValidateCollection[int]([]int{5, 0, 9, 4, 0}, decoded)
+			if !valid {
+				t.Error(msg)
+			}
+		})
+
+		t.Run(bc.name+" Should Round Trip When Empty", func(t *testing.T) {
+			data, err := bc.new().MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decoded := bc.new()
+			decoded.Add(1)
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+
+			if !decoded.IsEmpty() {
+				t.Errorf("Decoded collection is not empty: %s", decoded.String())
+			}
+		})
+
+		t.Run(bc.name+" Should Round Trip Through Gob", func(t *testing.T) {
+			c := bc.new()
+			c.Add(1, 2, 3)
+			var buf bytes.Buffer
+
+			if err := gob.NewEncoder(&buf).Encode(c); err != nil {
+				t.Fatal(err)
+			}
+			decoded := bc.new()
+			if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			valid, msg := ValidateCollection[int]([]int{1, 2, 3}, decoded)
+			if !valid {
+				t.Error(msg)
+			}
+		})
+	}
+}
+
+func TestBinary_Unmarshal(t *testing.T) {
+	t.Run("UnmarshalBinary Should Reject Data Without Header", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+
+		if err := q.UnmarshalBinary([]byte("not a collection")); err == nil {
+			t.Error("UnmarshalBinary accepted data without a header!")
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Unknown Versions", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1)
+		data, _ := q.MarshalBinary()
+		data[3] = 99
+
+		if err := cln.NewQueue[int]().UnmarshalBinary(data); err == nil {
+			t.Error("UnmarshalBinary accepted an unknown version!")
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Truncated Data", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+		data, _ := q.MarshalBinary()
+
+		if err := cln.NewQueue[int]().UnmarshalBinary(data[:len(data)-1]); err == nil {
+			t.Error("UnmarshalBinary accepted truncated data!")
+		}
+	})
+
+	t.Run("Queue Should Decode Encoding of Stack", func(t *testing.T) {
+		st := cln.NewStack[string]()
+		st.Add("a", "b")
+		data, _ := st.MarshalBinary()
+
+		q := cln.NewQueue[string]()
+		if err := q.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+
+		valid, msg := ValidateCollection[string]([]string{"a", "b"}, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestBinary_Stream(t *testing.T) {
+	t.Run("DecodeFrom Should Read What EncodeTo Streams Through a Pipe", func(t *testing.T) {
+		q := cln.NewChunkedQueue[int]()
+		q.Add(span(0, 10000)...)
+		r, w := io.Pipe()
+
+		go func() {
+			w.CloseWithError(q.EncodeTo(w))
+		}()
+
+		decoded := cln.NewChunkedQueue[int]()
+		if err := decoded.DecodeFrom(r); err != nil {
+			t.Fatal(err)
+		}
+
+		valid, msg := ValidateCollection[int](span(0, 10000), decoded)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
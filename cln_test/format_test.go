@@ -0,0 +1,113 @@
+package cln_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Fails the test unless formatting the given value with the given format yields exp.
+func expectFormat(t *testing.T, format string, val any, exp string) {
+	t.Helper()
+
+	if got := fmt.Sprintf(format, val); got != exp {
+		t.Errorf("Unexpected %s output!\nExpected: %s\nGot: %s", format, exp, got)
+	}
+}
+
+func TestFormat_Compact(t *testing.T) {
+	t.Run("Verb v Should Print Elements in Iteration Order For Every Collection", func(t *testing.T) {
+		for _, c := range []cln.Collection[int]{
+			cln.QueueOf(1, 2, 3),
+			cln.ChunkedQueueOf(1, 2, 3),
+			cln.StackOf(1, 2, 3),
+			cln.CopyOnWriteListOf(1, 2, 3),
+			cln.Synchronized[int](cln.QueueOf(1, 2, 3)),
+		} {
+			expectFormat(t, "%v", c, "[1 2 3]")
+			expectFormat(t, "%s", c, "[1 2 3]")
+		}
+	})
+
+	t.Run("Verb v Should Print Empty Brackets When Collection is Empty", func(t *testing.T) {
+		expectFormat(t, "%v", cln.NewQueue[int](), "[]")
+		expectFormat(t, "%+v", cln.NewStack[int](), "stack{size: 0, elems: []}")
+	})
+
+	t.Run("Other Verbs Should Apply to Each Element", func(t *testing.T) {
+		expectFormat(t, "%x", cln.QueueOf(10, 255), "[a ff]")
+		expectFormat(t, "%q", cln.StackOf("a", "b"), `["a" "b"]`)
+		expectFormat(t, "%03d", cln.QueueOf(1, 2), "[001 002]")
+	})
+}
+
+func TestFormat_Annotated(t *testing.T) {
+	t.Run("Verb +v Should Name Head and Tail of Queues", func(t *testing.T) {
+		expectFormat(t, "%+v", cln.QueueOf(1, 2, 3), "queue{size: 3, head: 1, tail: 3, elems: [1 2 3]}")
+		expectFormat(t, "%+v", cln.ChunkedQueueOf(1, 2, 3), "chunkedQueue{size: 3, head: 1, tail: 3, elems: [1 2 3]}")
+	})
+
+	t.Run("Verb +v Should Name Bottom and Top of Stacks", func(t *testing.T) {
+		expectFormat(t, "%+v", cln.StackOf(1, 2, 3), "stack{size: 3, bottom: 1, top: 3, elems: [1 2 3]}")
+	})
+
+	t.Run("Verb +v Should Print Field Names of Struct Elements", func(t *testing.T) {
+		type point struct{ X, Y int }
+
+		expectFormat(t, "%+v", cln.CopyOnWriteListOf(point{1, 2}),
+			"copyOnWriteList{size: 1, first: {X:1 Y:2}, last: {X:1 Y:2}, elems: [{X:1 Y:2}]}")
+	})
+}
+
+func TestFormat_GoSyntax(t *testing.T) {
+	t.Run("Verb #v Should Print Constructor Call When Collection Holds Ints", func(t *testing.T) {
+		expectFormat(t, "%#v", cln.QueueOf(1, 2, 3), "cln.QueueOf[int](1, 2, 3)")
+		expectFormat(t, "%#v", cln.StackOf[int](), "cln.StackOf[int]()")
+		expectFormat(t, "%#v", cln.Synchronized[int](cln.ChunkedQueueOf(4)),
+			"cln.Synchronized[int](cln.ChunkedQueueOf[int](4))")
+	})
+
+	t.Run("Verb #v Should Quote Strings", func(t *testing.T) {
+		expectFormat(t, "%#v", cln.CopyOnWriteListOf("a b", `"`), `cln.CopyOnWriteListOf[string]("a b", "\"")`)
+	})
+
+	t.Run("Verb #v Should Name Element Types That Aren't Predeclared", func(t *testing.T) {
+		expectFormat(t, "%#v", cln.QueueOf(time.Duration(5)), "cln.QueueOf[time.Duration](5)")
+		expectFormat(t, "%#v", cln.Synchronized[[2]int](cln.StackOf([2]int{1, 2})),
+			"cln.Synchronized[[2]int](cln.StackOf[[2]int]([2]int{1, 2}))")
+	})
+
+	t.Run("Verb #v Should Not Truncate", func(t *testing.T) {
+		got := fmt.Sprintf("%#v", cln.QueueOf(span(0, 1000)...))
+		if strings.Contains(got, "...") || !strings.HasSuffix(got, ", 999)") {
+			t.Errorf("Go syntax was truncated: %s", got[len(got)-40:])
+		}
+	})
+}
+
+func TestFormat_Limit(t *testing.T) {
+	t.Run("Verb v Should Elide Elements Past FormatLimit", func(t *testing.T) {
+		defer func(limit int) { cln.FormatLimit = limit }(cln.FormatLimit)
+		cln.FormatLimit = 3
+
+		expectFormat(t, "%v", cln.QueueOf(span(0, 10)...), "[0 1 2 ...+7]")
+		expectFormat(t, "%+v", cln.StackOf(span(0, 10)...), "stack{size: 10, bottom: 0, top: 9, elems: [0 1 2 ...+7]}")
+		expectFormat(t, "%v", cln.QueueOf(1, 2, 3), "[1 2 3]")
+	})
+
+	t.Run("Precision Should Override FormatLimit", func(t *testing.T) {
+		expectFormat(t, "%.2v", cln.ChunkedQueueOf(span(0, 100)...), "[0 1 ...+98]")
+	})
+
+	t.Run("Verb v Should Print Every Element When FormatLimit is Zero", func(t *testing.T) {
+		defer func(limit int) { cln.FormatLimit = limit }(cln.FormatLimit)
+		cln.FormatLimit = 0
+
+		if got := fmt.Sprint(cln.QueueOf(span(0, 200)...)); strings.Contains(got, "...") {
+			t.Errorf("Output was truncated: %s", got)
+		}
+	})
+}
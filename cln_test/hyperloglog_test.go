@@ -0,0 +1,150 @@
+package cln_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Returns the relative error of the given estimate of the exact count.
+func relativeError(estimate uint64, exact int) float64 {
+	return math.Abs(float64(estimate)-float64(exact)) / float64(exact)
+}
+
+func TestHyperLogLog_Count(t *testing.T) {
+	t.Run("Count Should Be Close to Exact Count From Map", func(t *testing.T) {
+		for _, precision := range []int{10, 14} {
+			tolerance := 4 * 1.04 / math.Sqrt(float64(int(1)<<precision))
+			for _, distinct := range []int{10, 100, 1000, 5000, 20000, 100000, 500000} {
+				h := cln.NewHyperLogLog[string](precision)
+				exact := make(map[string]struct{})
+				rng := rand.New(rand.NewSource(int64(distinct)))
+
+				// Add each user a few times, as a stream of page views would.
+				for len(exact) < distinct {
+					user := fmt.Sprintf("user-%d", rng.Intn(distinct*4))
+					exact[user] = struct{}{}
+					h.Add(user, user)
+				}
+
+				if err := relativeError(h.Count(), len(exact)); err > tolerance {
+					t.Errorf("Precision %d estimated %d distinct users but there are %d (error %.2f%%, tolerance %.2f%%)",
+						precision, h.Count(), len(exact), 100*err, 100*tolerance)
+				}
+			}
+		}
+	})
+
+	t.Run("Count Should Be Unbiased Between 2.5 and 5 Times the Registers", func(t *testing.T) {
+		for _, c := range []struct{ precision, trials int }{{10, 100}, {14, 40}} {
+			m := float64(int(1) << c.precision)
+			// Three standard errors of the mean of the trials' errors.
+			tolerance := 3 * 1.04 / math.Sqrt(m) / math.Sqrt(float64(c.trials))
+
+			for _, load := range []float64{2.6, 3.5, 4.5} {
+				distinct := int(load * m)
+				sum := 0.0
+				for trial := 0; trial < c.trials; trial++ {
+					h := cln.NewHyperLogLog[int](c.precision)
+					h.Add(span(trial*distinct, (trial+1)*distinct)...)
+					sum += (float64(h.Count()) - float64(distinct)) / float64(distinct)
+				}
+
+				if mean := sum / float64(c.trials); math.Abs(mean) > tolerance {
+					t.Errorf("Precision %d is biased by %+.2f%% at %d distinct elements (tolerance %.2f%%)",
+						c.precision, 100*mean, distinct, 100*tolerance)
+				}
+			}
+		}
+	})
+
+	t.Run("Count Should Be Nearly Exact When Few Distinct Elements Were Added", func(t *testing.T) {
+		h := cln.NewHyperLogLog[int](14)
+		for i := 0; i < 3; i++ {
+			h.Add(span(0, 2000)...)
+		}
+
+		if h.Count() < 1990 || h.Count() > 2010 {
+			t.Errorf("Estimated %d distinct elements but 2000 were added", h.Count())
+		}
+	})
+
+	t.Run("Count Should Return Zero When Nothing Was Added", func(t *testing.T) {
+		if count := cln.NewHyperLogLog[int](14).Count(); count != 0 {
+			t.Errorf("Expected 0 but got %d", count)
+		}
+	})
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	t.Run("Merge Should Estimate Cardinality of Union", func(t *testing.T) {
+		// Sparse with sparse, sparse with dense and dense with dense.
+		for _, sizes := range [][2]int{{1000, 1000}, {1000, 50000}, {50000, 50000}} {
+			a := cln.NewHyperLogLog[int](14)
+			b := cln.NewHyperLogLog[int](14)
+			a.Add(span(0, sizes[0])...)
+			b.Add(span(sizes[0]/2, sizes[0]/2+sizes[1])...)
+			exact := sizes[0]/2 + sizes[1]
+
+			if err := a.Merge(b); err != nil {
+				t.Fatal(err)
+			}
+
+			if err := relativeError(a.Count(), exact); err > 0.03 {
+				t.Errorf("Merged estimate is %d but the union holds %d", a.Count(), exact)
+			}
+		}
+	})
+
+	t.Run("Merge Should Fail When Precisions Differ", func(t *testing.T) {
+		if err := cln.NewHyperLogLog[int](10).Merge(cln.NewHyperLogLog[int](12)); err == nil {
+			t.Error("Merge accepted estimators of different precisions!")
+		}
+	})
+}
+
+func TestHyperLogLog_MarshalBinary(t *testing.T) {
+	t.Run("UnmarshalBinary Should Restore Estimator", func(t *testing.T) {
+		for _, distinct := range []int{100, 100000} {
+			h := cln.NewHyperLogLog[int](12)
+			h.Add(span(0, distinct)...)
+			data, err := h.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			restored := cln.NewHyperLogLog[int](4)
+			if err := restored.UnmarshalBinary(data); err != nil {
+				t.Fatal(err)
+			}
+
+			if restored.Count() != h.Count() || restored.Precision() != 12 {
+				t.Errorf("Restored estimator counts %d at precision %d but the original counts %d at precision 12",
+					restored.Count(), restored.Precision(), h.Count())
+			}
+		}
+	})
+
+	t.Run("MarshalBinary Should Be Smaller Than Registers When Few Elements Were Added", func(t *testing.T) {
+		h := cln.NewHyperLogLog[int](14)
+		h.Add(span(0, 100)...)
+		data, _ := h.MarshalBinary()
+
+		if len(data) >= 1<<14 {
+			t.Errorf("Encoding of 100 elements takes %d bytes", len(data))
+		}
+	})
+
+	t.Run("UnmarshalBinary Should Reject Truncated Data", func(t *testing.T) {
+		h := cln.NewHyperLogLog[int](14)
+		h.Add(span(0, 100)...)
+		data, _ := h.MarshalBinary()
+
+		if err := cln.NewHyperLogLog[int](14).UnmarshalBinary(data[:len(data)-2]); err == nil {
+			t.Error("UnmarshalBinary accepted truncated data!")
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package cln_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// The collections whose JSON encoding is tested, each created by its constructor.
+var jsonCollections = []struct {
+	name string
+	new  func() cln.Collection[int]
+}{
+	{"Queue", func() cln.Collection[int] { return cln.NewQueue[int]() }},
+	{"ChunkedQueue", func() cln.Collection[int] { return cln.NewChunkedQueue[int]() }},
+	{"Stack", func() cln.Collection[int] { return cln.NewStack[int]() }},
+	{"CopyOnWriteList", func() cln.Collection[int] { return cln.NewCopyOnWriteList[int]() }},
+	{"Synchronized", func() cln.Collection[int] { return cln.Synchronized[int](cln.NewQueue[int]()) }},
+}
+
+func TestJSON_Marshal(t *testing.T) {
+	for _, jc := range jsonCollections {
+		t.Run(jc.name+" Should Encode as Array in Iteration Order", func(t *testing.T) {
+			c := jc.new()
+			c.Add(3, 1, 2)
+
+			data, err := json.Marshal(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != "[3,1,2]" {
+				t.Errorf("\nExpected: [3,1,2]\nGot: %s", data)
+			}
+		})
+
+		t.Run(jc.name+" Should Encode as Empty Array When Empty", func(t *testing.T) {
+			data, err := json.Marshal(jc.new())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(data) != "[]" {
+				t.Errorf("\nExpected: []\nGot: %s", data)
+			}
+		})
+	}
+}
+
+func TestJSON_Unmarshal(t *testing.T) {
+	for _, jc := range jsonCollections {
+		t.Run(jc.name+" Should Restore Order on Round Trip", func(t *testing.T) {
+			c := jc.new()
+			c.Add(5, 4, 9, 4)
+			data, err := json.Marshal(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			decoded := jc.new()
+			decoded.Add(100)
+			if err := json.Unmarshal(data, decoded); err != nil {
+				t.Fatal(err)
+			}
+
+			valid, msg := ValidateCollection[int]([]int{5, 4, 9, 4}, decoded)
+			if !valid {
+				t.Error(msg)
+			}
+			if err := decoded.Validate(); err != nil {
+				t.Error(err)
+			}
+		})
+
+		t.Run(jc.name+" Should Return Error When JSON is Not an Array", func(t *testing.T) {
+			c := jc.new()
+
+			if err := json.Unmarshal([]byte(`{"a":1}`), c); err == nil {
+				t.Error("Unmarshal of an object returned no error!")
+			}
+		})
+	}
+
+	t.Run("Stack Should Take Last Encoded Element First After Round Trip", func(t *testing.T) {
+		st := cln.NewStack[string]()
+		st.Add("bottom", "middle", "top")
+		data, _ := json.Marshal(st)
+
+		decoded := cln.NewStack[string]()
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if val, _ := decoded.Take(); val != "top" {
+			t.Errorf("Take returned %q but expected \"top\"", val)
+		}
+	})
+}
+
+// A jobBatch is a batch of job IDs, used to nest a collection inside another collection.
+type jobBatch struct {
+	jobs cln.Collection[int]
+}
+
+func (b *jobBatch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.jobs)
+}
+
+func (b *jobBatch) UnmarshalJSON(data []byte) error {
+	b.jobs = cln.NewQueue[int]()
+	return json.Unmarshal(data, b.jobs)
+}
+
+func TestJSON_Nested(t *testing.T) {
+	t.Run("Stack of Queues Should Survive Round Trip", func(t *testing.T) {
+		first, second := &jobBatch{cln.NewQueue[int]()}, &jobBatch{cln.NewQueue[int]()}
+		first.jobs.Add(1, 2)
+		second.jobs.Add(3)
+		st := cln.NewStack[*jobBatch]()
+		st.Add(first, second)
+
+		data, err := json.Marshal(st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "[[1,2],[3]]" {
+			t.Errorf("\nExpected: [[1,2],[3]]\nGot: %s", data)
+		}
+
+		decoded := cln.NewStack[*jobBatch]()
+		if err := json.Unmarshal(data, decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		top, _ := decoded.Take()
+		bottom, _ := decoded.Take()
+		if valid, msg := ValidateCollection[int]([]int{3}, top.jobs); !valid {
+			t.Error(msg)
+		}
+		if valid, msg := ValidateCollection[int]([]int{1, 2}, bottom.jobs); !valid {
+			t.Error(msg)
+		}
+	})
+}
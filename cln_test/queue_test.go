@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/SMTanami/collections/cln"
+	"github.com/SMTanami/collections/clntest"
 )
 
 func TestQueue_Add(t *testing.T) {
@@ -54,6 +55,36 @@ func TestQueue_Add(t *testing.T) {
 	})
 }
 
+func TestQueue_AddAll(t *testing.T) {
+	t.Run("AddAll Should Append Elements of Other Collection in Order", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2)
+		other := cln.NewStack[int]()
+		other.Add(3, 4, 5)
+		exp := []int{1, 2, 3, 4, 5}
+
+		q.AddAll(other)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("AddAll Should Duplicate Elements When Given the Queue Itself", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2)
+		exp := []int{1, 2, 1, 2}
+
+		q.AddAll(q)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
 func TestQueue_Take(t *testing.T) {
 	t.Run("Take Should Return Nil when Queue is Empty", func(t *testing.T) {
 		q := cln.NewQueue[int]()
@@ -198,6 +229,85 @@ func TestQueue_Contains(t *testing.T) {
 	})
 }
 
+func TestQueue_ContainsAll(t *testing.T) {
+	t.Run("ContainsAll Should Return True When No Elements are Given", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+
+		if !q.ContainsAll() {
+			t.Error("ContainsAll() returned false when given no elements!")
+		}
+	})
+
+	t.Run("ContainsAll Should Return True When Queue Contains Every Element", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+
+		if !q.ContainsAll(3, 7, 1) {
+			t.Errorf("ContainsAll(3, 7, 1) returned false for queue: %s", q.String())
+		}
+		if !q.ContainsAll(12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1) {
+			t.Errorf("ContainsAll of every element returned false for queue: %s", q.String())
+		}
+	})
+
+	t.Run("ContainsAll Should Return False When Queue is Missing an Element", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+
+		if q.ContainsAll(3, 70, 1) {
+			t.Errorf("ContainsAll(3, 70, 1) returned true for queue: %s", q.String())
+		}
+		if q.ContainsAll(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 13) {
+			t.Errorf("ContainsAll with a missing element returned true for queue: %s", q.String())
+		}
+	})
+}
+
+func TestQueue_ContainsAny(t *testing.T) {
+	t.Run("ContainsAny Should Return False When No Elements are Given", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		if q.ContainsAny() {
+			t.Error("ContainsAny() returned true when given no elements!")
+		}
+	})
+
+	t.Run("ContainsAny Should Return True When Queue Contains One of the Elements", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		if !q.ContainsAny(9, 8, 3) {
+			t.Errorf("ContainsAny(9, 8, 3) returned false for queue: %s", q.String())
+		}
+		if !q.ContainsAny(20, 21, 22, 23, 24, 25, 26, 27, 28, 2) {
+			t.Errorf("ContainsAny with a present element returned false for queue: %s", q.String())
+		}
+	})
+
+	t.Run("ContainsAny Should Return False When Queue Contains None of the Elements", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		if q.ContainsAny(20, 21, 22, 23, 24, 25, 26, 27, 28, 29) {
+			t.Errorf("ContainsAny with absent elements returned true for queue: %s", q.String())
+		}
+	})
+
+	t.Run("ContainsAny Should Not Allocate When Given Few Elements", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(span(0, 1000)...)
+
+		allocs := testing.AllocsPerRun(100, func() {
+			q.ContainsAny(2000, 999)
+		})
+
+		if allocs != 0 {
+			t.Errorf("ContainsAny with two elements allocated %v times!", allocs)
+		}
+	})
+}
+
 func TestQueue_Clear(t *testing.T) {
 	t.Run("Clear Should Empty the Queue of All Elements", func(t *testing.T) {
 		q := cln.NewQueue[int]()
@@ -297,6 +407,105 @@ func TestQueue_Filter(t *testing.T) {
 	})
 }
 
+func TestQueue_RemoveIf(t *testing.T) {
+	isGreaterThanTen := func(queueElement int) bool {
+		return queueElement > 10
+	}
+
+	t.Run("RemoveIf Should Return 0 When Queue is Empty", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+
+		removed := q.RemoveIf(isGreaterThanTen)
+		if removed != 0 {
+			t.Errorf("RemoveIf reported %d removals on an empty queue!", removed)
+		}
+	})
+
+	t.Run("RemoveIf Should Remove Matching Elements and Return Their Count", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(88, 2, 7, 3, 15, 3, 77, 5)
+		exp := []int{2, 7, 3, 3, 5}
+
+		removed := q.RemoveIf(isGreaterThanTen)
+
+		if removed != 3 {
+			t.Errorf("RemoveIf returned %d but expected 3!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("RemoveIf Should Keep Tail Valid When Last Element is Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 30)
+		exp := []int{1, 2, 4}
+
+		q.RemoveIf(isGreaterThanTen)
+		q.Add(4)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Errorf("Add after RemoveIf dropped data! %s", msg)
+		}
+	})
+
+	t.Run("RemoveIf Should Allow Adding When All Elements are Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(11, 12, 13)
+		exp := []int{1}
+
+		q.RemoveIf(isGreaterThanTen)
+		q.Add(1)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Errorf("Add after RemoveIf dropped data! %s", msg)
+		}
+	})
+}
+
+func TestQueue_RetainIf(t *testing.T) {
+	t.Run("RetainIf Should Keep Only Matching Elements and Return Count Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 4, 5, 6)
+		exp := []int{2, 4, 6}
+
+		removed := q.RetainIf(func(queueElement int) bool {
+			return queueElement%2 == 0
+		})
+
+		if removed != 3 {
+			t.Errorf("RetainIf returned %d but expected 3!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestQueue_Where(t *testing.T) {
+	t.Run("Where Should Return New Queue of Matching Elements and Leave Queue Unchanged", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 4, 5, 6)
+
+		evens := q.Where(func(queueElement int) bool {
+			return queueElement%2 == 0
+		})
+
+		valid, msg := ValidateCollection[int]([]int{2, 4, 6}, evens)
+		if !valid {
+			t.Error(msg)
+		}
+		valid, msg = ValidateCollection[int]([]int{1, 2, 3, 4, 5, 6}, q)
+		if !valid {
+			t.Errorf("Where modified the original queue! %s", msg)
+		}
+	})
+}
+
 func TestQueue_Remove(t *testing.T) {
 	t.Run("Remove Should Not Crash When Queue ss Empty", func(t *testing.T) {
 		q := cln.NewQueue[int]()
@@ -341,6 +550,193 @@ func TestQueue_Remove(t *testing.T) {
 			t.Errorf("Remove removed an element from the queue when it did not contain the given argument! %s", msg)
 		}
 	})
+	t.Run("Remove Should Report Whether an Element was Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(2, 5, 3)
+
+		if !q.Remove(5) {
+			t.Error("Remove(5) returned false when queue contained 5!")
+		}
+		if q.Remove(5) {
+			t.Error("Remove(5) returned true when queue no longer contained 5!")
+		}
+	})
+
+	t.Run("Remove Should Update Head When Head is Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(2, 5, 3)
+		exp := []int{5, 3}
+
+		q.Remove(2)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+		if val, _ := q.Peek(); val != 5 {
+			t.Errorf("Peek after removing head returned %d but expected 5!", val)
+		}
+	})
+
+	t.Run("Remove Should Update Tail When Tail is Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(2, 5, 3)
+		exp := []int{2, 5, 8}
+
+		q.Remove(3)
+		q.Add(8)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Errorf("Add after removing tail dropped data! %s", msg)
+		}
+	})
+
+	t.Run("Remove Should Leave Usable Queue When Only Element is Removed", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(2)
+		exp := []int{7}
+
+		q.Remove(2)
+		q.Add(7)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestQueue_IndexOf(t *testing.T) {
+	t.Run("IndexOf Should Return -1 When Queue Does Not Contain Element", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		if i := q.IndexOf(4); i != -1 {
+			t.Errorf("IndexOf(4) returned %d but expected -1!", i)
+		}
+	})
+
+	t.Run("IndexOf Should Return Index of First Instance Counting From Head", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 4, 3, 4)
+
+		if i := q.IndexOf(4); i != 1 {
+			t.Errorf("IndexOf(4) returned %d but expected 1!", i)
+		}
+	})
+}
+
+func TestQueue_LastIndexOf(t *testing.T) {
+	t.Run("LastIndexOf Should Return -1 When Queue Does Not Contain Element", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		if i := q.LastIndexOf(4); i != -1 {
+			t.Errorf("LastIndexOf(4) returned %d but expected -1!", i)
+		}
+	})
+
+	t.Run("LastIndexOf Should Return Index of Last Instance Counting From Head", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 4, 3, 4, 5)
+
+		if i := q.LastIndexOf(4); i != 3 {
+			t.Errorf("LastIndexOf(4) returned %d but expected 3!", i)
+		}
+	})
+}
+
+func TestQueue_RemoveAll(t *testing.T) {
+	t.Run("RemoveAll Should Return 0 When No Elements are Given", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		if removed := q.RemoveAll(); removed != 0 {
+			t.Errorf("RemoveAll() removed %d elements!", removed)
+		}
+	})
+
+	t.Run("RemoveAll Should Remove Every Instance of Each Given Element", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 2, 4, 1, 5)
+		exp := []int{3, 4, 5}
+
+		removed := q.RemoveAll(1, 2, 9)
+
+		if removed != 4 {
+			t.Errorf("RemoveAll returned %d but expected 4!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("RemoveAll Should Remove Every Instance When Given Many Elements", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 2, 4, 1, 5, 30)
+		exp := []int{3, 4, 5}
+
+		removed := q.RemoveAll(1, 2, 30, 31, 32, 33, 34, 35, 36, 37)
+
+		if removed != 5 {
+			t.Errorf("RemoveAll returned %d but expected 5!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestQueue_RemoveEvery(t *testing.T) {
+	t.Run("RemoveEvery Should Remove Every Instance of Given Element", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(4, 2, 4, 3, 4)
+		exp := []int{2, 3}
+
+		removed := q.RemoveEvery(4)
+
+		if removed != 3 {
+			t.Errorf("RemoveEvery returned %d but expected 3!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestQueue_RetainAll(t *testing.T) {
+	t.Run("RetainAll Should Keep Only Elements Contained in Other Collection", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3, 2, 4, 1, 5)
+		other := cln.NewStack[int]()
+		other.Add(2, 5)
+		exp := []int{2, 2, 5}
+
+		removed := q.RetainAll(other)
+
+		if removed != 4 {
+			t.Errorf("RetainAll returned %d but expected 4!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("RetainAll Should Empty Queue When Other Collection is Empty", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		q.Add(1, 2, 3)
+
+		q.RetainAll(cln.NewQueue[int]())
+
+		if !q.IsEmpty() {
+			t.Errorf("RetainAll with an empty collection left elements in queue: %s", q.String())
+		}
+	})
 }
 
 func TestQueue_Size(t *testing.T) {
@@ -439,6 +835,91 @@ func TestQueue_String(t *testing.T) {
 	})
 }
 
+func TestQueue_Trim(t *testing.T) {
+	t.Run("Pooled Queue Should Not Allocate When Adding and Taking in a Steady Loop", func(t *testing.T) {
+		q := cln.NewPooledQueue[int](16)
+		q.Add(1, 2, 3, 4)
+		q.Take()
+
+		allocs := testing.AllocsPerRun(100, func() {
+			q.Add(5)
+			q.Take()
+		})
+
+		if allocs != 0 {
+			t.Errorf("Pooled queue allocated %v times per Add/Take!", allocs)
+		}
+	})
+
+	t.Run("Trim Should Leave Queue Elements Unchanged", func(t *testing.T) {
+		q := cln.NewPooledQueue[int](16)
+		q.Add(1, 2, 3, 4, 5)
+		q.Take()
+		q.Remove(3)
+		exp := []int{2, 4, 5, 6}
+
+		q.Trim()
+		q.Add(6)
+
+		valid, msg := ValidateCollection[int](exp, q)
+		if !valid {
+			t.Error(msg)
+		}
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Pooled Queue Should Keep No More Nodes Than Its Cap", func(t *testing.T) {
+		q := cln.NewPooledQueue[int](2)
+		q.Add(1, 2, 3, 4, 5, 6)
+
+		q.Clear()
+		q.Add(1, 2, 3)
+		q.RemoveIf(func(int) bool { return true })
+
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Pooled Queue Should Not Allocate When Clearing and Refilling in a Loop", func(t *testing.T) {
+		q := cln.NewPooledQueue[int](16)
+		q.Add(1, 2, 3, 4)
+		q.Clear()
+
+		allocs := testing.AllocsPerRun(100, func() {
+			q.Add(1, 2, 3, 4)
+			q.Clear()
+		})
+
+		if allocs != 0 {
+			t.Errorf("Pooled queue allocated %v times per refill and Clear!", allocs)
+		}
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Pooled Queue Should Keep No Nodes When Its Cap is Negative", func(t *testing.T) {
+		q := cln.NewPooledQueue[int](-1)
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+
+		q.Add(1, 2, 3)
+		q.Take()
+
+		valid, msg := ValidateCollection[int]([]int{2, 3}, q)
+		if !valid {
+			t.Error(msg)
+		}
+		if err := q.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
 func TestQueue_Type(t *testing.T) {
 	t.Run("Queue Should Be a Collection", func(t *testing.T) {
 		var c cln.Collection[int]
@@ -450,3 +931,83 @@ func TestQueue_Type(t *testing.T) {
 		}
 	})
 }
+
+func TestQueue_Validate(t *testing.T) {
+	t.Run("Validate Should Return Nil When Queue is Empty", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+
+		if err := q.Validate(); err != nil {
+			t.Errorf("Validate failed on empty queue: %v", err)
+		}
+	})
+
+	t.Run("Validate Should Return Nil After Every Kind of Mutation", func(t *testing.T) {
+		q := cln.NewQueue[int]()
+		steps := []func(){
+			func() { q.Add(1, 2, 3, 4, 5, 6) },
+			func() { q.Take() },
+			func() { q.Remove(6) },
+			func() { q.Remove(2) },
+			func() { q.RemoveIf(func(v int) bool { return v > 4 }) },
+			func() { q.Add(7) },
+			func() { q.RemoveEvery(7) },
+			func() { q.Take() },
+			func() { q.Take() },
+			func() { q.Add(8) },
+			func() { q.Clear() },
+		}
+
+		for i, step := range steps {
+			step()
+			if err := q.Validate(); err != nil {
+				t.Fatalf("Validate failed after step %d on queue %s: %v", i, q.String(), err)
+			}
+		}
+	})
+}
+
+func TestQueue_Conformance(t *testing.T) {
+	clntest.Run(t, func() cln.Collection[int] {
+		return cln.NewQueue[int]()
+	}, clntest.FIFO)
+}
+
+func TestQueue_Model(t *testing.T) {
+	clntest.RunModel(t, func() cln.Collection[int] {
+		return cln.NewQueue[int]()
+	}, clntest.FIFO, clntest.ModelConfig{})
+}
+
+func TestPooledQueue_Conformance(t *testing.T) {
+	clntest.Run(t, func() cln.Collection[int] {
+		return cln.NewPooledQueue[int](8)
+	}, clntest.FIFO)
+}
+
+func TestPooledQueue_Model(t *testing.T) {
+	clntest.RunModel(t, func() cln.Collection[int] {
+		return cln.NewPooledQueue[int](8)
+	}, clntest.FIFO, clntest.ModelConfig{})
+}
+
+func FuzzQueue(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 6, 12, 1, 2, 3})
+	f.Add([]byte{0, 0, 6, 3, 4, 5, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		clntest.RunBytes(t, func() cln.Collection[int] {
+			return cln.NewQueue[int]()
+		}, clntest.FIFO, data)
+	})
+}
+
+func FuzzPooledQueue(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 6, 12, 1, 2, 3})
+	f.Add([]byte{0, 6, 12, 18, 5, 0, 6, 1, 1, 1})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		clntest.RunBytes(t, func() cln.Collection[int] {
+			return cln.NewPooledQueue[int](2)
+		}, clntest.FIFO, data)
+	})
+}
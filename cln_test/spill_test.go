@@ -0,0 +1,136 @@
+package cln_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/SMTanami/collections/cln/durable"
+)
+
+func TestSpillQueue_Take(t *testing.T) {
+	t.Run("Take Should Return Elements in Order When Most Were Spilled", func(t *testing.T) {
+		q := durable.NewSpillQueue(durable.GobCodec[int](), durable.SpillOptions{MaxInMemory: 8, Dir: t.TempDir()})
+		defer q.Close()
+
+		if err := q.Add(span(0, 1000)...); err != nil {
+			t.Fatal(err)
+		}
+		if q.DiskBytes() == 0 {
+			t.Fatal("No elements were spilled to disk!")
+		}
+		if q.Size() != 1000 {
+			t.Fatalf("Expected size 1000 but got %d", q.Size())
+		}
+
+		expectInts(t, span(0, 1000), takeAll[int](t, q))
+		if q.DiskBytes() != 0 {
+			t.Errorf("Expected no bytes on disk after taking every element but got %d", q.DiskBytes())
+		}
+	})
+
+	t.Run("Take Should Return Elements in Order When Adds and Takes Interleave", func(t *testing.T) {
+		q := durable.NewSpillQueue(durable.GobCodec[int](), durable.SpillOptions{MaxInMemory: 6, Dir: t.TempDir()})
+		defer q.Close()
+
+		var got []int
+		next := 0
+		for round := 0; round < 50; round++ {
+			for i := 0; i < round%7+1; i++ {
+				q.Add(next)
+				next++
+			}
+			for i := 0; i < round%5; i++ {
+				if v, ok, err := q.Take(); err != nil {
+					t.Fatal(err)
+				} else if ok {
+					got = append(got, v)
+				}
+			}
+		}
+		got = append(got, takeAll[int](t, q)...)
+
+		expectInts(t, span(0, next), got)
+	})
+}
+
+func TestSpillQueue_Add(t *testing.T) {
+	t.Run("Add Should Return ErrDiskBudgetExceeded When Spilling Exceeds Budget", func(t *testing.T) {
+		opts := durable.SpillOptions{MaxInMemory: 4, MaxDiskBytes: 64, Dir: t.TempDir()}
+		q := durable.NewSpillQueue(durable.JSONCodec[int](), opts)
+		defer q.Close()
+
+		var err error
+		added := 0
+		for ; added < 1000 && err == nil; added++ {
+			err = q.Add(added)
+		}
+
+		if err != durable.ErrDiskBudgetExceeded {
+			t.Fatalf("Expected ErrDiskBudgetExceeded but got %v", err)
+		}
+		if q.DiskBytes() > opts.MaxDiskBytes {
+			t.Errorf("Spilled %d bytes, over the budget of %d", q.DiskBytes(), opts.MaxDiskBytes)
+		}
+		expectInts(t, span(0, added-1), takeAll[int](t, q))
+	})
+
+	t.Run("Add Should Reject Only the Element the Codec Can't Encode", func(t *testing.T) {
+		q := durable.NewSpillQueue[int](failingCodec{bad: 9}, durable.SpillOptions{MaxInMemory: 4, Dir: t.TempDir()})
+		defer q.Close()
+
+		var want []int
+		for i := 0; i < 30; i++ {
+			err := q.Add(i)
+			if i == 9 && err == nil {
+				t.Fatal("Add(9) returned nil but expected an encoding error")
+			}
+			if i != 9 && err != nil {
+				t.Fatalf("Add(%d) returned %v but expected nil", i, err)
+			}
+			if err == nil {
+				want = append(want, i)
+			}
+		}
+
+		expectInts(t, want, takeAll[int](t, q))
+	})
+}
+
+// A failingCodec is a JSON codec that fails to encode one value.
+type failingCodec struct {
+	bad int
+}
+
+func (c failingCodec) Encode(val int) ([]byte, error) {
+	if val == c.bad {
+		return nil, fmt.Errorf("can't encode %d", val)
+	}
+	return durable.JSONCodec[int]().Encode(val)
+}
+
+func (c failingCodec) Decode(data []byte) (int, error) {
+	return durable.JSONCodec[int]().Decode(data)
+}
+
+func TestSpillQueue_Close(t *testing.T) {
+	t.Run("Close Should Delete Spilled Files", func(t *testing.T) {
+		dir := t.TempDir()
+		q := durable.NewSpillQueue(durable.GobCodec[int](), durable.SpillOptions{MaxInMemory: 4, Dir: dir})
+		q.Add(span(0, 100)...)
+
+		if entries, _ := os.ReadDir(dir); len(entries) == 0 {
+			t.Fatal("No temporary directory was created!")
+		}
+		if err := q.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+			t.Errorf("Close left %d entries behind", len(entries))
+		}
+		if err := q.Add(1); err != durable.ErrClosed {
+			t.Errorf("Expected ErrClosed but got %v", err)
+		}
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/SMTanami/collections/cln"
+	"github.com/SMTanami/collections/clntest"
 )
 
 func TestStack_Add(t *testing.T) {
@@ -56,6 +57,36 @@ func TestStack_Add(t *testing.T) {
 	})
 }
 
+func TestStack_AddAll(t *testing.T) {
+	t.Run("AddAll Should Append Elements of Other Collection in Order", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2)
+		other := cln.NewStack[int]()
+		other.Add(3, 4, 5)
+		exp := []int{1, 2, 3, 4, 5}
+
+		st.AddAll(other)
+
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("AddAll Should Duplicate Elements When Given the Stack Itself", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2)
+		exp := []int{1, 2, 1, 2}
+
+		st.AddAll(st)
+
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
 func TestStack_Take(t *testing.T) {
 	t.Run("Take Should Return Nil when Stack is Empty", func(t *testing.T) {
 		st := cln.NewStack[int]()
@@ -175,6 +206,72 @@ func TestStack_Contains(t *testing.T) {
 	})
 }
 
+func TestStack_ContainsAll(t *testing.T) {
+	t.Run("ContainsAll Should Return True When No Elements are Given", func(t *testing.T) {
+		st := cln.NewStack[int]()
+
+		if !st.ContainsAll() {
+			t.Error("ContainsAll() returned false when given no elements!")
+		}
+	})
+
+	t.Run("ContainsAll Should Return True When Stack Contains Every Element", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+
+		if !st.ContainsAll(3, 7, 1) {
+			t.Errorf("ContainsAll(3, 7, 1) returned false for stack: %s", st.String())
+		}
+		if !st.ContainsAll(12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1) {
+			t.Errorf("ContainsAll of every element returned false for stack: %s", st.String())
+		}
+	})
+
+	t.Run("ContainsAll Should Return False When Stack is Missing an Element", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+
+		if st.ContainsAll(3, 70, 1) {
+			t.Errorf("ContainsAll(3, 70, 1) returned true for stack: %s", st.String())
+		}
+		if st.ContainsAll(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 13) {
+			t.Errorf("ContainsAll with a missing element returned true for stack: %s", st.String())
+		}
+	})
+}
+
+func TestStack_ContainsAny(t *testing.T) {
+	t.Run("ContainsAny Should Return False When No Elements are Given", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		if st.ContainsAny() {
+			t.Error("ContainsAny() returned true when given no elements!")
+		}
+	})
+
+	t.Run("ContainsAny Should Return True When Stack Contains One of the Elements", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		if !st.ContainsAny(9, 8, 3) {
+			t.Errorf("ContainsAny(9, 8, 3) returned false for stack: %s", st.String())
+		}
+		if !st.ContainsAny(20, 21, 22, 23, 24, 25, 26, 27, 28, 2) {
+			t.Errorf("ContainsAny with a present element returned false for stack: %s", st.String())
+		}
+	})
+
+	t.Run("ContainsAny Should Return False When Stack Contains None of the Elements", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		if st.ContainsAny(20, 21, 22, 23, 24, 25, 26, 27, 28, 29) {
+			t.Errorf("ContainsAny with absent elements returned true for stack: %s", st.String())
+		}
+	})
+}
+
 func TestStack_Clear(t *testing.T) {
 	t.Run("Clear Should Empty the Stack of All Elements", func(t *testing.T) {
 		st := cln.NewStack[int]()
@@ -274,6 +371,105 @@ func TestStack_Filter(t *testing.T) {
 	})
 }
 
+func TestStack_RemoveIf(t *testing.T) {
+	isGreaterThanTen := func(stackElement int) bool {
+		return stackElement > 10
+	}
+
+	t.Run("RemoveIf Should Return 0 When Stack is Empty", func(t *testing.T) {
+		st := cln.NewStack[int]()
+
+		removed := st.RemoveIf(isGreaterThanTen)
+		if removed != 0 {
+			t.Errorf("RemoveIf reported %d removals on an empty stack!", removed)
+		}
+	})
+
+	t.Run("RemoveIf Should Remove Matching Elements and Return Their Count", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(88, 2, 7, 3, 15, 3, 77, 5)
+		exp := []int{2, 7, 3, 3, 5}
+
+		removed := st.RemoveIf(isGreaterThanTen)
+
+		if removed != 3 {
+			t.Errorf("RemoveIf returned %d but expected 3!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("RemoveIf Should Keep Order When Top Element is Removed", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 30)
+		exp := []int{1, 2, 4}
+
+		st.RemoveIf(isGreaterThanTen)
+		st.Add(4)
+
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Errorf("Add after RemoveIf dropped data! %s", msg)
+		}
+	})
+
+	t.Run("RemoveIf Should Allow Adding When All Elements are Removed", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(11, 12, 13)
+		exp := []int{1}
+
+		st.RemoveIf(isGreaterThanTen)
+		st.Add(1)
+
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Errorf("Add after RemoveIf dropped data! %s", msg)
+		}
+	})
+}
+
+func TestStack_RetainIf(t *testing.T) {
+	t.Run("RetainIf Should Keep Only Matching Elements and Return Count Removed", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 4, 5, 6)
+		exp := []int{2, 4, 6}
+
+		removed := st.RetainIf(func(stackElement int) bool {
+			return stackElement%2 == 0
+		})
+
+		if removed != 3 {
+			t.Errorf("RetainIf returned %d but expected 3!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestStack_Where(t *testing.T) {
+	t.Run("Where Should Return New Stack of Matching Elements and Leave Stack Unchanged", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 4, 5, 6)
+
+		evens := st.Where(func(stackElement int) bool {
+			return stackElement%2 == 0
+		})
+
+		valid, msg := ValidateCollection[int]([]int{2, 4, 6}, evens)
+		if !valid {
+			t.Error(msg)
+		}
+		valid, msg = ValidateCollection[int]([]int{1, 2, 3, 4, 5, 6}, st)
+		if !valid {
+			t.Errorf("Where modified the original stack! %s", msg)
+		}
+	})
+}
+
 func TestStack_Remove(t *testing.T) {
 	t.Run("Remove Should Not Crash When Stack ss Empty", func(t *testing.T) {
 		st := cln.NewStack[int]()
@@ -318,6 +514,149 @@ func TestStack_Remove(t *testing.T) {
 			t.Errorf("Remove removed an element from the stueue when it did not contain the given argument! %s", msg)
 		}
 	})
+	t.Run("Remove Should Report Whether an Element was Removed", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(2, 5, 3)
+
+		if !st.Remove(5) {
+			t.Error("Remove(5) returned false when stack contained 5!")
+		}
+		if st.Remove(5) {
+			t.Error("Remove(5) returned true when stack no longer contained 5!")
+		}
+	})
+}
+
+func TestStack_IndexOf(t *testing.T) {
+	t.Run("IndexOf Should Return -1 When Stack Does Not Contain Element", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		if i := st.IndexOf(4); i != -1 {
+			t.Errorf("IndexOf(4) returned %d but expected -1!", i)
+		}
+	})
+
+	t.Run("IndexOf Should Return Index of First Instance Counting From Bottom", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 4, 3, 4)
+
+		if i := st.IndexOf(4); i != 1 {
+			t.Errorf("IndexOf(4) returned %d but expected 1!", i)
+		}
+	})
+}
+
+func TestStack_LastIndexOf(t *testing.T) {
+	t.Run("LastIndexOf Should Return -1 When Stack Does Not Contain Element", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		if i := st.LastIndexOf(4); i != -1 {
+			t.Errorf("LastIndexOf(4) returned %d but expected -1!", i)
+		}
+	})
+
+	t.Run("LastIndexOf Should Return Index of Last Instance Counting From Bottom", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 4, 3, 4, 5)
+
+		if i := st.LastIndexOf(4); i != 3 {
+			t.Errorf("LastIndexOf(4) returned %d but expected 3!", i)
+		}
+	})
+}
+
+func TestStack_RemoveAll(t *testing.T) {
+	t.Run("RemoveAll Should Return 0 When No Elements are Given", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		if removed := st.RemoveAll(); removed != 0 {
+			t.Errorf("RemoveAll() removed %d elements!", removed)
+		}
+	})
+
+	t.Run("RemoveAll Should Remove Every Instance of Each Given Element", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 2, 4, 1, 5)
+		exp := []int{3, 4, 5}
+
+		removed := st.RemoveAll(1, 2, 9)
+
+		if removed != 4 {
+			t.Errorf("RemoveAll returned %d but expected 4!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("RemoveAll Should Remove Every Instance When Given Many Elements", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 2, 4, 1, 5, 30)
+		exp := []int{3, 4, 5}
+
+		removed := st.RemoveAll(1, 2, 30, 31, 32, 33, 34, 35, 36, 37)
+
+		if removed != 5 {
+			t.Errorf("RemoveAll returned %d but expected 5!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestStack_RemoveEvery(t *testing.T) {
+	t.Run("RemoveEvery Should Remove Every Instance of Given Element", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(4, 2, 4, 3, 4)
+		exp := []int{2, 3}
+
+		removed := st.RemoveEvery(4)
+
+		if removed != 3 {
+			t.Errorf("RemoveEvery returned %d but expected 3!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestStack_RetainAll(t *testing.T) {
+	t.Run("RetainAll Should Keep Only Elements Contained in Other Collection", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3, 2, 4, 1, 5)
+		other := cln.NewStack[int]()
+		other.Add(2, 5)
+		exp := []int{2, 2, 5}
+
+		removed := st.RetainAll(other)
+
+		if removed != 4 {
+			t.Errorf("RetainAll returned %d but expected 4!", removed)
+		}
+		valid, msg := ValidateCollection[int](exp, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("RetainAll Should Empty Stack When Other Collection is Empty", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		st.RetainAll(cln.NewQueue[int]())
+
+		if !st.IsEmpty() {
+			t.Errorf("RetainAll with an empty collection left elements in stack: %s", st.String())
+		}
+	})
 }
 
 func TestStack_Size(t *testing.T) {
@@ -416,6 +755,139 @@ func TestStack_String(t *testing.T) {
 	})
 }
 
+func TestStack_Cap(t *testing.T) {
+	t.Run("Cap Should Shrink After Spike When Stack is Mostly Taken", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		for i := 0; i < 100000; i++ {
+			st.Add(i)
+		}
+		peak := st.Cap()
+
+		for i := 0; i < 99990; i++ {
+			st.Take()
+		}
+
+		if st.Cap() >= peak/4 {
+			t.Errorf("Stack kept capacity %d of peak %d after shrinking to 10 elements!", st.Cap(), peak)
+		}
+		valid, msg := ValidateCollection[int](span(0, 10), st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("Cap Should Shrink When RemoveIf Leaves Stack Mostly Empty", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(span(0, 1000)...)
+		peak := st.Cap()
+
+		st.RemoveIf(func(v int) bool { return v >= 10 })
+
+		if st.Cap() >= peak {
+			t.Errorf("Stack kept capacity %d after RemoveIf left 10 elements!", st.Cap())
+		}
+		if err := st.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestStack_Grow(t *testing.T) {
+	t.Run("Grow Should Make Room for N More Elements", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(1, 2, 3)
+
+		st.Grow(100)
+
+		if st.Cap() < 103 {
+			t.Errorf("Cap after Grow(100) is %d but expected at least 103!", st.Cap())
+		}
+		valid, msg := ValidateCollection[int]([]int{1, 2, 3}, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("Grow Should Not Allocate When Adding Within Grown Capacity", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Grow(1000)
+		before := st.Cap()
+
+		for i := 0; i < 1000; i++ {
+			st.Add(i)
+		}
+
+		if st.Cap() != before {
+			t.Errorf("Stack reallocated from capacity %d to %d!", before, st.Cap())
+		}
+	})
+
+	t.Run("Grow Should Reserve Capacity That Taking Elements Keeps", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Add(span(0, 100)...)
+		st.Grow(1000)
+		before := st.Cap()
+
+		for i := 0; i < 99; i++ {
+			st.Take()
+		}
+
+		if st.Cap() != before {
+			t.Errorf("Cap after Grow and Take is %d but expected %d!", st.Cap(), before)
+		}
+		if err := st.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("Stack Should Still Shrink Capacity Grown Past Its Reservation", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Grow(100)
+		st.Add(span(0, 10000)...)
+
+		for i := 0; i < 9990; i++ {
+			st.Take()
+		}
+
+		if st.Cap() < 100 || st.Cap() > 1000 {
+			t.Errorf("Cap after taking all but 10 elements is %d but expected between 100 and 1000!", st.Cap())
+		}
+		valid, msg := ValidateCollection[int](span(0, 10), st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+}
+
+func TestStack_ShrinkToFit(t *testing.T) {
+	t.Run("ShrinkToFit Should Reduce Capacity to Size", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Grow(50)
+		st.Add(1, 2, 3)
+
+		st.ShrinkToFit()
+
+		if st.Cap() != 3 {
+			t.Errorf("Cap after ShrinkToFit is %d but expected 3!", st.Cap())
+		}
+		valid, msg := ValidateCollection[int]([]int{1, 2, 3}, st)
+		if !valid {
+			t.Error(msg)
+		}
+	})
+
+	t.Run("ShrinkToFit Should Release Capacity of Empty Stack", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Grow(50)
+
+		st.ShrinkToFit()
+
+		if st.Cap() != 0 {
+			t.Errorf("Cap after ShrinkToFit is %d but expected 0!", st.Cap())
+		}
+	})
+}
+
 func TestStack_Type(t *testing.T) {
 	t.Run("Queue Should Be a Collection", func(t *testing.T) {
 		var c cln.Collection[int]
@@ -427,3 +899,60 @@ func TestStack_Type(t *testing.T) {
 		}
 	})
 }
+
+func TestStack_Validate(t *testing.T) {
+	t.Run("Validate Should Return Nil When Queue is Empty", func(t *testing.T) {
+		st := cln.NewStack[int]()
+
+		if err := st.Validate(); err != nil {
+			t.Errorf("Validate failed on empty stack: %v", err)
+		}
+	})
+
+	t.Run("Validate Should Return Nil After Every Kind of Mutation", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		steps := []func(){
+			func() { st.Add(1, 2, 3, 4, 5, 6) },
+			func() { st.Take() },
+			func() { st.Remove(6) },
+			func() { st.Remove(2) },
+			func() { st.RemoveIf(func(v int) bool { return v > 4 }) },
+			func() { st.Add(7) },
+			func() { st.RemoveEvery(7) },
+			func() { st.Take() },
+			func() { st.Take() },
+			func() { st.Add(8) },
+			func() { st.Clear() },
+		}
+
+		for i, step := range steps {
+			step()
+			if err := st.Validate(); err != nil {
+				t.Fatalf("Validate failed after step %d on stack %s: %v", i, st.String(), err)
+			}
+		}
+	})
+}
+
+func TestStack_Conformance(t *testing.T) {
+	clntest.Run(t, func() cln.Collection[int] {
+		return cln.NewStack[int]()
+	}, clntest.LIFO)
+}
+
+func TestStack_Model(t *testing.T) {
+	clntest.RunModel(t, func() cln.Collection[int] {
+		return cln.NewStack[int]()
+	}, clntest.LIFO, clntest.ModelConfig{})
+}
+
+func FuzzStack(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 6, 12, 1, 2, 3})
+	f.Add([]byte{0, 0, 6, 3, 4, 5, 0})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		clntest.RunBytes(t, func() cln.Collection[int] {
+			return cln.NewStack[int]()
+		}, clntest.LIFO, data)
+	})
+}
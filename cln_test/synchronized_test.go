@@ -0,0 +1,102 @@
+package cln_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+	"github.com/SMTanami/collections/clntest"
+)
+
+func TestSynchronized_Add(t *testing.T) {
+	t.Run("Add Should Keep Every Element When Called From Many Goroutines", func(t *testing.T) {
+		c := cln.Synchronized[int](cln.NewQueue[int]())
+		var wg sync.WaitGroup
+
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 1000; i++ {
+					c.Add(g*1000 + i)
+					c.Contains(i)
+				}
+			}(g)
+		}
+		wg.Wait()
+
+		if c.Size() != 8000 {
+			t.Errorf("Size is %d but expected 8000!", c.Size())
+		}
+		if err := c.Validate(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestSynchronized_Iter(t *testing.T) {
+	t.Run("Iter Should Yield Snapshot When Collection is Modified While Iterating", func(t *testing.T) {
+		c := cln.Synchronized[int](cln.NewStack[int]())
+		c.Add(1, 2, 3)
+		exp := []int{1, 2, 3}
+
+		var got []int
+		for v := range c.Iter() {
+			got = append(got, v)
+			c.Add(v * 10)
+		}
+
+		if len(got) != len(exp) {
+			t.Fatalf("\nExpected: %v\nGot: %v", exp, got)
+		}
+		for i := range exp {
+			if got[i] != exp[i] {
+				t.Fatalf("\nExpected: %v\nGot: %v", exp, got)
+			}
+		}
+		if c.Size() != 6 {
+			t.Errorf("Size is %d but expected 6!", c.Size())
+		}
+	})
+}
+
+func TestSynchronized_Atomically(t *testing.T) {
+	t.Run("Atomically Should Make Check-Then-Act Safe Across Goroutines", func(t *testing.T) {
+		c := cln.Synchronized[int](cln.NewQueue[int]())
+		var wg sync.WaitGroup
+
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					c.Atomically(func(c cln.Collection[int]) {
+						if !c.Contains(i) {
+							c.Add(i)
+						}
+					})
+				}
+			}()
+		}
+		wg.Wait()
+
+		valid, msg := ValidateCollection[int](span(0, 100), c)
+		if !valid {
+			t.Errorf("Atomically allowed duplicates! %s", msg)
+		}
+	})
+}
+
+func TestSynchronized_Conformance(t *testing.T) {
+	t.Run("Queue", func(t *testing.T) {
+		clntest.Run(t, func() cln.Collection[int] {
+			return cln.Synchronized[int](cln.NewQueue[int]())
+		}, clntest.FIFO)
+	})
+
+	t.Run("Stack", func(t *testing.T) {
+		clntest.Run(t, func() cln.Collection[int] {
+			return cln.Synchronized[int](cln.NewStack[int]())
+		}, clntest.LIFO)
+	})
+}
@@ -0,0 +1,100 @@
+package cln_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Returns the k most frequent elements of the given exact counts.
+func exactTopK(exact map[string]uint64, k int) []string {
+	var all []string
+	for endpoint := range exact {
+		all = append(all, endpoint)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return exact[all[i]] > exact[all[j]]
+	})
+
+	return all[:k]
+}
+
+// Fails the test unless every one of the expected elements is among the given heavy hitters.
+func expectHitters(t *testing.T, exp []string, got []cln.HeavyHitter[string]) {
+	t.Helper()
+
+	tracked := make(map[string]bool)
+	for _, h := range got {
+		tracked[h.Val] = true
+	}
+	for _, endpoint := range exp {
+		if !tracked[endpoint] {
+			t.Errorf("%s is among the most frequent but was not tracked; got %v", endpoint, got)
+		}
+	}
+}
+
+func TestTopK_TopK(t *testing.T) {
+	t.Run("TopK Should Return Most Frequent Elements in Descending Order", func(t *testing.T) {
+		tk := cln.NewTopK[string](10, 0.001, 0.01)
+		stream, exact := zipfStream(3, 200000)
+		for _, endpoint := range stream {
+			tk.Increment(endpoint)
+		}
+
+		top := tk.TopK()
+		if len(top) != 10 {
+			t.Fatalf("Expected 10 heavy hitters but got %d", len(top))
+		}
+		for i := 1; i < len(top); i++ {
+			if top[i].Count > top[i-1].Count {
+				t.Fatalf("Heavy hitters are not in descending order: %v", top)
+			}
+		}
+		for _, h := range top {
+			if h.Count < exact[h.Val] {
+				t.Errorf("%s has count %d but occurred %d times", h.Val, h.Count, exact[h.Val])
+			}
+		}
+
+		// The tail of the top ten is close enough for estimation error to reorder it, so only check the top five.
+		expectHitters(t, exactTopK(exact, 5), top)
+	})
+
+	t.Run("TopK Should Return Fewer Than K When Fewer Distinct Elements Were Counted", func(t *testing.T) {
+		tk := cln.NewTopK[string](5, 0.01, 0.01)
+		tk.IncrementBy("/a", 3)
+		tk.Increment("/b")
+
+		top := tk.TopK()
+		if len(top) != 2 || top[0] != (cln.HeavyHitter[string]{Val: "/a", Count: 3}) || top[1].Val != "/b" {
+			t.Errorf("Unexpected heavy hitters %v", top)
+		}
+	})
+}
+
+func TestTopK_Merge(t *testing.T) {
+	t.Run("Merge Should Track Heavy Hitters of Both Streams", func(t *testing.T) {
+		a := cln.NewTopK[string](10, 0.001, 0.01)
+		b := cln.NewTopK[string](10, 0.001, 0.01)
+		stream, exact := zipfStream(4, 200000)
+		for i, endpoint := range stream {
+			if i%2 == 0 {
+				a.Increment(endpoint)
+			} else {
+				b.Increment(endpoint)
+			}
+		}
+
+		if err := a.Merge(b); err != nil {
+			t.Fatal(err)
+		}
+
+		expectHitters(t, exactTopK(exact, 5), a.TopK())
+		top := a.TopK()[0]
+		if top.Count < exact[top.Val] {
+			t.Errorf("Merged count of %s is %d but it occurred %d times", top.Val, top.Count, exact[top.Val])
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package cln_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+	"github.com/SMTanami/collections/cln/viz"
+)
+
+// Returns the target of the edge leaving the node with the given ID, or "" if there is none.
+func edgeFrom(s cln.Structure, from string) string {
+	for _, e := range s.Edges {
+		if e.From == from {
+			return e.To
+		}
+	}
+	return ""
+}
+
+// Returns the label of the node with the given ID, or "" if there is none.
+func labelOf(s cln.Structure, id string) string {
+	for _, n := range s.Nodes {
+		if n.ID == id {
+			return n.Label
+		}
+	}
+	return ""
+}
+
+func TestStructure_Queue(t *testing.T) {
+	t.Run("Structure Should Point Head and Tail at First and Last Nodes", func(t *testing.T) {
+		s := cln.QueueOf(1, 2, 3).Structure()
+
+		if got := labelOf(s, edgeFrom(s, "head")); got != "1" {
+			t.Errorf("Expected head to point at 1 but it points at %q", got)
+		}
+		if got := labelOf(s, edgeFrom(s, "tail")); got != "3" {
+			t.Errorf("Expected tail to point at 3 but it points at %q", got)
+		}
+		if got := labelOf(s, edgeFrom(s, edgeFrom(s, edgeFrom(s, "head")))); got != "3" {
+			t.Errorf("Expected the node two after head to hold 3 but it holds %q", got)
+		}
+	})
+
+	t.Run("Structure Should Point Head and Tail at Nil When Queue is Empty", func(t *testing.T) {
+		s := cln.NewQueue[int]().Structure()
+
+		if got := labelOf(s, edgeFrom(s, "head")); got != "nil" {
+			t.Errorf("Expected head to be nil but it points at %q", got)
+		}
+	})
+
+	t.Run("Structure Should Include Free List When Queue is Pooled", func(t *testing.T) {
+		q := cln.NewPooledQueue[int](4)
+		q.Add(1, 2, 3)
+		q.Take()
+		q.Take()
+		s := q.Structure()
+
+		if got := labelOf(s, "free"); got != "free (2/4)" {
+			t.Errorf("Unexpected free list pointer label %q", got)
+		}
+		if edgeFrom(s, edgeFrom(s, "free")) == "" {
+			t.Error("Free list does not link its two nodes")
+		}
+	})
+}
+
+func TestStructure_Stack(t *testing.T) {
+	t.Run("Structure Should Draw Spare Capacity as Empty Slots", func(t *testing.T) {
+		st := cln.NewStack[int]()
+		st.Grow(4)
+		st.Add(1, 2)
+		s := st.Structure()
+
+		empty := 0
+		for _, n := range s.Nodes {
+			if n.Kind == cln.EmptySlotNode {
+				empty++
+			}
+		}
+		if empty < 2 {
+			t.Errorf("Expected at least 2 empty slots but got %d", empty)
+		}
+		if got := labelOf(s, edgeFrom(s, "top")); got != "2" {
+			t.Errorf("Expected top to point at 2 but it points at %q", got)
+		}
+	})
+}
+
+func TestStructure_Synchronized(t *testing.T) {
+	t.Run("Structure Should Describe Underlying Collection", func(t *testing.T) {
+		s := cln.Synchronized[int](cln.ChunkedQueueOf(1, 2)).Structure()
+
+		if s.Name != "synchronized chunkedQueue" {
+			t.Errorf("Unexpected name %q", s.Name)
+		}
+		if got := labelOf(s, "chunk0"); got != "[0:2] 1 2" {
+			t.Errorf("Unexpected chunk label %q", got)
+		}
+	})
+}
+
+func TestViz_DOT(t *testing.T) {
+	t.Run("DOT Should Render Queue Nodes and Pointers", func(t *testing.T) {
+		exp := `digraph "queue" {
+	rankdir=LR;
+	node [shape=box];
+	"head" [label="head", shape=plaintext];
+	"tail" [label="tail", shape=plaintext];
+	subgraph cluster_0 {
+		label="nodes";
+		"node0" [label="1"];
+		"node1" [label="2"];
+		"node0" -> "node1" [style=invis];
+	}
+	"node0" -> "node1" [label="next"];
+	"head" -> "node0";
+	"tail" -> "node1";
+}
+`
+		if got := viz.DOT(cln.QueueOf(1, 2).Structure()); got != exp {
+			t.Errorf("Unexpected DOT!\nExpected:\n%s\nGot:\n%s", exp, got)
+		}
+	})
+
+	t.Run("DOT Should Escape Quotes in Labels", func(t *testing.T) {
+		got := viz.DOT(cln.QueueOf(`say "hi"`).Structure())
+
+		if !strings.Contains(got, `[label="say \"hi\""]`) {
+			t.Errorf("Label was not escaped:\n%s", got)
+		}
+	})
+
+	t.Run("DOT Should Fill Colored Nodes", func(t *testing.T) {
+		tree := cln.Structure{Name: "tree", TopDown: true, Nodes: []cln.StructureNode{
+			{ID: "n0", Label: "5", Color: "black"},
+			{ID: "n1", Label: "3", Color: "red"},
+		}, Edges: []cln.StructureEdge{{From: "n0", To: "n1", Label: "left"}}}
+		got := viz.DOT(tree)
+
+		if strings.Contains(got, "rankdir") {
+			t.Error("Top down structure was drawn left to right")
+		}
+		if !strings.Contains(got, `"n1" [label="3", fillcolor="red", style="filled"]`) {
+			t.Errorf("Colored node was not filled:\n%s", got)
+		}
+	})
+}
+
+func TestViz_Mermaid(t *testing.T) {
+	t.Run("Mermaid Should Render Stack Slots and Top Pointer", func(t *testing.T) {
+		exp := `flowchart LR
+	%% stack
+	top(["top"])
+	subgraph group_0 ["pile (len 1, cap 1)"]
+		direction LR
+		slot0["7"]
+	end
+	top --> slot0
+`
+		if got := viz.Mermaid(cln.StackOf(7).Structure()); got != exp {
+			t.Errorf("Unexpected Mermaid!\nExpected:\n%s\nGot:\n%s", exp, got)
+		}
+	})
+
+	t.Run("Mermaid Should Render Heap Array as Tree", func(t *testing.T) {
+		got := viz.Mermaid(viz.Heap([]int{1, 4, 2, 8}))
+
+		for _, line := range []string{"flowchart TD", "heap0 --> heap1", "heap0 --> heap2", "heap1 --> heap3", `heap3["[3] 8"]`} {
+			if !strings.Contains(got, line) {
+				t.Errorf("Expected %q in:\n%s", line, got)
+			}
+		}
+	})
+
+	t.Run("Mermaid Should Style Colored Nodes", func(t *testing.T) {
+		tree := cln.Structure{Nodes: []cln.StructureNode{{ID: "n0", Label: `"x"`, Color: "red"}}}
+		got := viz.Mermaid(tree)
+
+		if !strings.Contains(got, "style n0 fill:red") || !strings.Contains(got, `n0["#quot;x#quot;"]`) {
+			t.Errorf("Unexpected Mermaid:\n%s", got)
+		}
+	})
+}
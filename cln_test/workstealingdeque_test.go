@@ -0,0 +1,161 @@
+package cln_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+func TestWorkStealingDeque_Pop(t *testing.T) {
+	t.Run("Pop Should Return False When Deque is Empty", func(t *testing.T) {
+		d := cln.NewWorkStealingDeque[int]()
+
+		if val, ok := d.Pop(); ok {
+			t.Errorf("Pop returned (%d, true) on empty deque!", val)
+		}
+	})
+
+	t.Run("Pop Should Return Elements in LIFO Order Across Growth", func(t *testing.T) {
+		d := cln.NewWorkStealingDeque[int]()
+		for i := 0; i < 1000; i++ {
+			d.Push(i)
+		}
+
+		for i := 999; i >= 0; i-- {
+			val, ok := d.Pop()
+			if !ok || val != i {
+				t.Fatalf("Pop returned (%d, %t) but expected (%d, true)", val, ok, i)
+			}
+		}
+		if !d.IsEmpty() {
+			t.Errorf("Deque has size %d after popping every element!", d.Size())
+		}
+	})
+}
+
+func TestWorkStealingDeque_Steal(t *testing.T) {
+	t.Run("Steal Should Return Elements in FIFO Order", func(t *testing.T) {
+		d := cln.NewWorkStealingDeque[int]()
+		for i := 0; i < 100; i++ {
+			d.Push(i)
+		}
+
+		for i := 0; i < 100; i++ {
+			val, ok := d.Steal()
+			if !ok || val != i {
+				t.Fatalf("Steal returned (%d, %t) but expected (%d, true)", val, ok, i)
+			}
+		}
+		if _, ok := d.Steal(); ok {
+			t.Error("Steal returned true on empty deque!")
+		}
+	})
+
+	t.Run("Every Element Should Be Taken Exactly Once When Thieves Steal Concurrently", func(t *testing.T) {
+		const elements = 20000
+		d := cln.NewWorkStealingDeque[int]()
+		taken := make([]int32, elements)
+		var wg sync.WaitGroup
+		var done atomic.Bool
+
+		for thief := 0; thief < 4; thief++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for !done.Load() || !d.IsEmpty() {
+					if val, ok := d.Steal(); ok {
+						atomic.AddInt32(&taken[val], 1)
+					} else {
+						runtime.Gosched()
+					}
+				}
+			}()
+		}
+
+		for i := 0; i < elements; i++ {
+			d.Push(i)
+			if i%3 == 0 {
+				if val, ok := d.Pop(); ok {
+					atomic.AddInt32(&taken[val], 1)
+				}
+			}
+		}
+		for val, ok := d.Pop(); ok; val, ok = d.Pop() {
+			atomic.AddInt32(&taken[val], 1)
+		}
+		done.Store(true)
+		wg.Wait()
+
+		for val, count := range taken {
+			if count != 1 {
+				t.Fatalf("Element %d was taken %d times!", val, count)
+			}
+		}
+	})
+}
+
+// A sumTask adds up the integers in [lo, hi).
+type sumTask struct {
+	lo, hi int
+}
+
+// Example_workStealingScheduler shows a minimal scheduler: every worker owns a deque, splits large tasks into halves
+// that it pushes onto its own deque, and steals from the other workers when its own deque runs dry.
+func Example_workStealingScheduler() {
+	type taskDeque interface {
+		Push(task sumTask)
+		Pop() (sumTask, bool)
+		Steal() (sumTask, bool)
+	}
+
+	const workers = 4
+	deques := make([]taskDeque, workers)
+	for i := range deques {
+		deques[i] = cln.NewWorkStealingDeque[sumTask]()
+	}
+
+	var total, pending atomic.Int64
+	pending.Store(1)
+	deques[0].Push(sumTask{0, 1_000_000})
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			own := deques[w]
+			for pending.Load() > 0 {
+				task, ok := own.Pop()
+				for victim := (w + 1) % workers; !ok && victim != w; victim = (victim + 1) % workers {
+					task, ok = deques[victim].Steal()
+				}
+				if !ok {
+					runtime.Gosched()
+					continue
+				}
+
+				if task.hi-task.lo > 1000 {
+					mid := (task.lo + task.hi) / 2
+					pending.Add(2)
+					own.Push(sumTask{task.lo, mid})
+					own.Push(sumTask{mid, task.hi})
+				} else {
+					sum := 0
+					for i := task.lo; i < task.hi; i++ {
+						sum += i
+					}
+					total.Add(int64(sum))
+				}
+				pending.Add(-1)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	fmt.Println(total.Load())
+	// Output: 499999500000
+}
@@ -0,0 +1,413 @@
+// Package clntest provides a reusable conformance suite for implementations of cln.Collection. Given a constructor and
+// the ordering model the implementation follows, Run checks the full behavioral contract of the interface so that every
+// collection - inside or outside of cln - is held to the same standard.
+package clntest
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// An Ordering describes the order in which a collection hands out its elements.
+type Ordering int
+
+const (
+	// Take returns the oldest element. Iteration runs from the oldest element to the newest.
+	FIFO Ordering = iota
+	// Take returns the newest element. Iteration runs from the bottom (oldest) element to the top (newest).
+	LIFO
+	// Take returns the smallest element. Iteration order is unspecified.
+	Priority
+	// Take may return any element. Iteration order is unspecified.
+	Unordered
+)
+
+// Returns the name of the ordering.
+func (o Ordering) String() string {
+	switch o {
+	case FIFO:
+		return "FIFO"
+	case LIFO:
+		return "LIFO"
+	case Priority:
+		return "Priority"
+	case Unordered:
+		return "Unordered"
+	default:
+		return fmt.Sprintf("Ordering(%d)", int(o))
+	}
+}
+
+// Runs the behavioral contract of cln.Collection against fresh collections returned by newCollection, expecting the
+// given ordering. Each part of the contract runs as its own subtest. The suite exercises collections of int; the
+// contract itself is independent of the element type.
+func Run(t *testing.T, newCollection func() cln.Collection[int], order Ordering) {
+	t.Helper()
+
+	s := suite{newCollection: newCollection, order: order}
+	t.Run("New Collection Should Be Empty", s.testEmpty)
+	t.Run("Add Should Grow Collection", s.testAdd)
+	t.Run("AddAll Should Add Every Element of Other Collection", s.testAddAll)
+	t.Run("Take Should Follow Ordering", s.testTake)
+	t.Run("Iter Should Follow Ordering", s.testIter)
+	t.Run("Contains Should Report Membership", s.testContains)
+	t.Run("IndexOf Should Agree With Iter", s.testIndexOf)
+	t.Run("Remove Should Remove a Single Instance", s.testRemove)
+	t.Run("RemoveAll and RemoveEvery Should Remove Every Instance", s.testRemoveAll)
+	t.Run("RemoveIf and RetainIf Should Report Removal Counts", s.testRemoveIf)
+	t.Run("Filter Should Behave Like RemoveIf", s.testFilter)
+	t.Run("RetainAll Should Keep Elements of Other Collection", s.testRetainAll)
+	t.Run("Where Should Leave Collection Unchanged", s.testWhere)
+	t.Run("Clear Should Empty Collection", s.testClear)
+}
+
+// A suite holds what every part of the contract needs to build and check collections.
+type suite struct {
+	newCollection func() cln.Collection[int]
+	order         Ordering
+}
+
+// Returns a new collection holding the given values, added in order.
+func (s suite) filled(vals ...int) cln.Collection[int] {
+	c := s.newCollection()
+	c.Add(vals...)
+	return c
+}
+
+// Fails the test if the collection does not hold exactly the given values, which are listed in insertion order. For
+// FIFO and LIFO collections the iteration order must match as well. Also checks Size, IsEmpty and Validate.
+func (s suite) check(t *testing.T, c cln.Collection[int], want []int) {
+	t.Helper()
+
+	if err := c.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v\nGot: %s", err, c.String())
+	}
+	if c.Size() != len(want) {
+		t.Fatalf("Size is %d but expected %d\nExpected: %v\nGot: %s", c.Size(), len(want), want, c.String())
+	}
+	if c.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("IsEmpty returned %t for collection of size %d", c.IsEmpty(), len(want))
+	}
+
+	got := drain(c)
+	if s.order == FIFO || s.order == LIFO {
+		if !equal(got, want) {
+			t.Fatalf("Iteration order is wrong\nExpected: %v\nGot: %v", want, got)
+		}
+		return
+	}
+
+	if !equal(sorted(got), sorted(want)) {
+		t.Fatalf("Elements are wrong\nExpected (any order): %v\nGot: %v", want, got)
+	}
+}
+
+// Returns the values in the order a collection following the suite's ordering hands them out through Take, or nil if
+// that order is unspecified.
+func (s suite) takeOrder(vals []int) []int {
+	switch s.order {
+	case FIFO:
+		return append([]int(nil), vals...)
+	case LIFO:
+		reversed := make([]int, len(vals))
+		for i, v := range vals {
+			reversed[len(vals)-1-i] = v
+		}
+		return reversed
+	case Priority:
+		return sorted(vals)
+	default:
+		return nil
+	}
+}
+
+// Returns the model values after removing the instance of val that a collection following the suite's ordering removes
+// first: the oldest for FIFO, the newest for LIFO and, as the exact instance is unobservable, the oldest otherwise.
+func (s suite) removeOne(model []int, val int) []int {
+	i := -1
+	for j, v := range model {
+		if v == val {
+			i = j
+			if s.order != LIFO {
+				break
+			}
+		}
+	}
+
+	if i == -1 {
+		return model
+	}
+
+	return append(append([]int(nil), model[:i]...), model[i+1:]...)
+}
+
+func (s suite) testEmpty(t *testing.T) {
+	c := s.newCollection()
+
+	s.check(t, c, nil)
+	if val, ok := c.Take(); ok || val != 0 {
+		t.Errorf("Take on empty collection returned (%d, %t) but expected (0, false)", val, ok)
+	}
+	if c.Contains(0) {
+		t.Error("Contains(0) returned true on empty collection!")
+	}
+	if c.Remove(0) {
+		t.Error("Remove(0) returned true on empty collection!")
+	}
+	_ = c.String()
+}
+
+func (s suite) testAdd(t *testing.T) {
+	c := s.newCollection()
+
+	c.Add(5)
+	s.check(t, c, []int{5})
+
+	c.Add(3, 8, 3)
+	s.check(t, c, []int{5, 3, 8, 3})
+
+	c.Add()
+	s.check(t, c, []int{5, 3, 8, 3})
+}
+
+func (s suite) testAddAll(t *testing.T) {
+	c := s.filled(1, 2)
+	other := s.filled(3, 4)
+
+	c.AddAll(other)
+
+	s.check(t, c, []int{1, 2, 3, 4})
+	s.check(t, other, []int{3, 4})
+
+	c.AddAll(c)
+	s.check(t, c, []int{1, 2, 3, 4, 1, 2, 3, 4})
+}
+
+func (s suite) testTake(t *testing.T) {
+	vals := []int{4, 9, 1, 7, 1, 3}
+	c := s.filled(vals...)
+	exp := s.takeOrder(vals)
+
+	var got []int
+	for !c.IsEmpty() {
+		val, ok := c.Take()
+		if !ok {
+			t.Fatalf("Take returned false on collection of size %d", c.Size())
+		}
+		got = append(got, val)
+		if err := c.Validate(); err != nil {
+			t.Fatalf("Validate failed after Take: %v", err)
+		}
+	}
+
+	if exp != nil && !equal(got, exp) {
+		t.Fatalf("Take order is wrong\nExpected: %v\nGot: %v", exp, got)
+	}
+	if !equal(sorted(got), sorted(vals)) {
+		t.Fatalf("Take returned the wrong elements\nExpected (any order): %v\nGot: %v", vals, got)
+	}
+	if _, ok := c.Take(); ok {
+		t.Error("Take returned true after collection was drained!")
+	}
+
+	c.Add(6)
+	s.check(t, c, []int{6})
+}
+
+func (s suite) testIter(t *testing.T) {
+	vals := []int{10, 20, 30, 20, 10}
+	c := s.filled(vals...)
+
+	s.check(t, c, vals)
+	s.check(t, c, vals)
+}
+
+func (s suite) testContains(t *testing.T) {
+	c := s.filled(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12)
+
+	if !c.Contains(7) {
+		t.Error("Contains(7) returned false!")
+	}
+	if c.Contains(13) {
+		t.Error("Contains(13) returned true!")
+	}
+	if !c.ContainsAll() || !c.ContainsAll(1, 12) || !c.ContainsAll(12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1) {
+		t.Error("ContainsAll returned false for present elements!")
+	}
+	if c.ContainsAll(1, 13) || c.ContainsAll(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 13) {
+		t.Error("ContainsAll returned true when an element is missing!")
+	}
+	if c.ContainsAny() || c.ContainsAny(13, 14) || c.ContainsAny(13, 14, 15, 16, 17, 18, 19, 20, 21, 22) {
+		t.Error("ContainsAny returned true for absent elements!")
+	}
+	if !c.ContainsAny(13, 5) || !c.ContainsAny(13, 14, 15, 16, 17, 18, 19, 20, 21, 5) {
+		t.Error("ContainsAny returned false when an element is present!")
+	}
+}
+
+func (s suite) testIndexOf(t *testing.T) {
+	c := s.filled(3, 1, 4, 1, 5)
+	got := drain(c)
+
+	if i := c.IndexOf(9); i != -1 {
+		t.Errorf("IndexOf(9) returned %d but expected -1", i)
+	}
+	if i := c.LastIndexOf(9); i != -1 {
+		t.Errorf("LastIndexOf(9) returned %d but expected -1", i)
+	}
+
+	first, last := c.IndexOf(1), c.LastIndexOf(1)
+	if first < 0 || first >= len(got) || got[first] != 1 {
+		t.Fatalf("IndexOf(1) returned %d which does not point at 1 in %v", first, got)
+	}
+	if last < 0 || last >= len(got) || got[last] != 1 {
+		t.Fatalf("LastIndexOf(1) returned %d which does not point at 1 in %v", last, got)
+	}
+	if first >= last {
+		t.Errorf("IndexOf(1) = %d is not before LastIndexOf(1) = %d in %v", first, last, got)
+	}
+}
+
+func (s suite) testRemove(t *testing.T) {
+	model := []int{2, 5, 3, 5, 1}
+	c := s.filled(model...)
+
+	if c.Remove(9) {
+		t.Error("Remove(9) returned true for absent element!")
+	}
+	s.check(t, c, model)
+
+	for _, val := range []int{5, 2, 1, 5, 3} {
+		if !c.Remove(val) {
+			t.Fatalf("Remove(%d) returned false for present element!", val)
+		}
+		model = s.removeOne(model, val)
+		s.check(t, c, model)
+	}
+
+	c.Add(7)
+	s.check(t, c, []int{7})
+}
+
+func (s suite) testRemoveAll(t *testing.T) {
+	c := s.filled(1, 2, 3, 2, 4, 1, 5)
+
+	if removed := c.RemoveAll(); removed != 0 {
+		t.Errorf("RemoveAll() returned %d but expected 0", removed)
+	}
+	if removed := c.RemoveAll(1, 2, 9); removed != 4 {
+		t.Errorf("RemoveAll(1, 2, 9) returned %d but expected 4", removed)
+	}
+	s.check(t, c, []int{3, 4, 5})
+
+	c.Add(4, 6, 4)
+	if removed := c.RemoveEvery(4); removed != 3 {
+		t.Errorf("RemoveEvery(4) returned %d but expected 3", removed)
+	}
+	s.check(t, c, []int{3, 5, 6})
+
+	if removed := c.RemoveAll(3, 5, 6, 30, 31, 32, 33, 34, 35, 36); removed != 3 {
+		t.Errorf("RemoveAll with many elements returned %d but expected 3", removed)
+	}
+	s.check(t, c, nil)
+
+	c.Add(8)
+	s.check(t, c, []int{8})
+}
+
+func (s suite) testRemoveIf(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+	c := s.filled(1, 2, 3, 4, 5, 6, 8)
+
+	if removed := c.RemoveIf(isEven); removed != 4 {
+		t.Errorf("RemoveIf returned %d but expected 4", removed)
+	}
+	s.check(t, c, []int{1, 3, 5})
+
+	c.Add(9, 10)
+	if removed := c.RetainIf(func(v int) bool { return v > 4 }); removed != 2 {
+		t.Errorf("RetainIf returned %d but expected 2", removed)
+	}
+	s.check(t, c, []int{5, 9, 10})
+
+	if removed := c.RemoveIf(func(int) bool { return true }); removed != 3 {
+		t.Errorf("RemoveIf returned %d but expected 3", removed)
+	}
+	s.check(t, c, nil)
+
+	c.Add(11)
+	s.check(t, c, []int{11})
+}
+
+func (s suite) testFilter(t *testing.T) {
+	c := s.filled(88, 2, 7, 15, 3, 77)
+
+	c.Filter(func(v int) bool { return v > 10 })
+
+	s.check(t, c, []int{2, 7, 3})
+}
+
+func (s suite) testRetainAll(t *testing.T) {
+	c := s.filled(1, 2, 3, 2, 4, 1, 5)
+
+	if removed := c.RetainAll(s.filled(2, 5)); removed != 4 {
+		t.Errorf("RetainAll returned %d but expected 4", removed)
+	}
+	s.check(t, c, []int{2, 2, 5})
+
+	c.RetainAll(s.newCollection())
+	s.check(t, c, nil)
+}
+
+func (s suite) testWhere(t *testing.T) {
+	c := s.filled(1, 2, 3, 4, 5, 6)
+
+	evens := c.Where(func(v int) bool { return v%2 == 0 })
+
+	s.check(t, evens, []int{2, 4, 6})
+	s.check(t, c, []int{1, 2, 3, 4, 5, 6})
+}
+
+func (s suite) testClear(t *testing.T) {
+	c := s.filled(1, 2, 3)
+
+	c.Clear()
+	s.check(t, c, nil)
+
+	c.Add(4)
+	s.check(t, c, []int{4})
+}
+
+// Returns the collection's elements in iteration order.
+func drain(c cln.Collection[int]) []int {
+	var vals []int
+	for v := range c.Iter() {
+		vals = append(vals, v)
+	}
+
+	return vals
+}
+
+// Returns a sorted copy of the given values.
+func sorted(vals []int) []int {
+	cp := append([]int(nil), vals...)
+	sort.Ints(cp)
+	return cp
+}
+
+// Returns true if both slices hold the same values in the same order.
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,94 @@
+package clntest
+
+import (
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// A priorityQueue is a minimal Priority collection: a queue whose Take and Peek hand out the smallest element.
+type priorityQueue struct {
+	cln.Collection[int]
+}
+
+func newPriorityQueue() cln.Collection[int] {
+	return priorityQueue{cln.NewQueue[int]()}
+}
+
+func (q priorityQueue) Peek() (int, bool) {
+	vals := drain(q)
+	if len(vals) == 0 {
+		return 0, false
+	}
+
+	return sorted(vals)[0], true
+}
+
+func (q priorityQueue) Take() (int, bool) {
+	val, ok := q.Peek()
+	if ok {
+		q.Remove(val)
+	}
+
+	return val, ok
+}
+
+// A bag is a minimal Unordered collection: a queue whose Take hands out its middle element.
+type bag struct {
+	cln.Collection[int]
+}
+
+func newBag() cln.Collection[int] {
+	return bag{cln.NewQueue[int]()}
+}
+
+func (b bag) Take() (int, bool) {
+	vals := drain(b)
+	if len(vals) == 0 {
+		return 0, false
+	}
+
+	val := vals[len(vals)/2]
+	b.Remove(val)
+	return val, true
+}
+
+// A maxFirstQueue is a broken priority queue whose Take hands out the largest element instead of the smallest.
+type maxFirstQueue struct {
+	cln.Collection[int]
+}
+
+func (q maxFirstQueue) Take() (int, bool) {
+	vals := drain(q)
+	if len(vals) == 0 {
+		return 0, false
+	}
+
+	val := sorted(vals)[len(vals)-1]
+	q.Remove(val)
+	return val, true
+}
+
+// A miscountingBag is a broken bag whose Take removes an element but returns one more than it.
+type miscountingBag struct {
+	bag
+}
+
+func (b miscountingBag) Take() (int, bool) {
+	val, ok := b.bag.Take()
+	if ok {
+		val++
+	}
+
+	return val, ok
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Run Should Pass for Priority Collection", func(t *testing.T) {
+		Run(t, newPriorityQueue, Priority)
+	})
+
+	t.Run("Run Should Pass for Unordered Collection", func(t *testing.T) {
+		Run(t, newBag, Unordered)
+	})
+}
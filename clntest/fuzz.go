@@ -0,0 +1,47 @@
+package clntest
+
+import (
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// Decodes data into a sequence of operations, one per byte, applies them to a fresh collection returned by
+// newCollection and fails the test on the first divergence from the reference model of the given ordering. It is meant
+// to be called from the function passed to testing.F.Fuzz:
+//
+//	func FuzzMyCollection(f *testing.F) {
+//		f.Add([]byte{0, 6, 1})
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			clntest.RunBytes(t, NewMyCollection, clntest.FIFO, data)
+//		})
+//	}
+//
+// After every operation the collection's invariants, size, elements and - for FIFO and LIFO orderings - String are
+// checked against the model.
+func RunBytes(t *testing.T, newCollection func() cln.Collection[int], order Ordering, data []byte) {
+	t.Helper()
+
+	_, canPeek := newCollection().(peeker)
+	ops := decodeOps(data, canPeek)
+	if err := runOps(newCollection, order, ops); err != nil {
+		t.Fatalf("Collection diverged from %s model\nSequence: %s\n%v", order, formatOps(ops), err)
+	}
+}
+
+// Returns the operations encoded by data. The low part of each byte, modulo the amount of operation kinds, selects the
+// operation and the rest selects a value between 0 and 9.
+func decodeOps(data []byte, canPeek bool) []op {
+	const kinds = int(opClear) + 1
+
+	ops := make([]op, len(data))
+	for i, b := range data {
+		kind := opKind(int(b) % kinds)
+		if kind == opPeek && !canPeek {
+			kind = opTake
+		}
+		ops[i] = op{kind: kind, val: int(b) / kinds % 10}
+	}
+
+	return ops
+}
@@ -0,0 +1,319 @@
+package clntest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// A ModelConfig tunes RunModel. The zero value is ready to use.
+type ModelConfig struct {
+	// Seed for the random operation sequences, which is reported on failure. Defaults to 1, so that every run checks the
+	// same sequences; set a different seed to explore others.
+	Seed int64
+	// Amount of random sequences to run. Defaults to 200.
+	Sequences int
+	// Maximum amount of operations in a sequence. Defaults to 64.
+	MaxOps int
+}
+
+// Runs random sequences of Add, Take, Peek, Remove, Filter and Clear operations against fresh collections returned by
+// newCollection and compares every result with a slice backed reference model following the given ordering. Peek is
+// only exercised when the collection has a Peek() (int, bool) method. The first failing sequence is shrunk to a minimal
+// reproduction before being reported.
+func RunModel(t *testing.T, newCollection func() cln.Collection[int], order Ordering, cfg ModelConfig) {
+	t.Helper()
+
+	if cfg.Seed == 0 {
+		cfg.Seed = 1
+	}
+	if cfg.Sequences <= 0 {
+		cfg.Sequences = 200
+	}
+	if cfg.MaxOps <= 0 {
+		cfg.MaxOps = 64
+	}
+
+	_, canPeek := newCollection().(peeker)
+	r := rand.New(rand.NewSource(cfg.Seed))
+
+	for i := 0; i < cfg.Sequences; i++ {
+		ops := randomOps(r, 1+r.Intn(cfg.MaxOps), canPeek)
+		if err := runOps(newCollection, order, ops); err != nil {
+			ops = shrink(newCollection, order, ops)
+			t.Fatalf("Collection diverged from %s model (seed %d)\nMinimal sequence: %s\n%v",
+				order, cfg.Seed, formatOps(ops), runOps(newCollection, order, ops))
+		}
+	}
+}
+
+// A peeker is a collection that can return the element Take would return without removing it.
+type peeker interface {
+	Peek() (int, bool)
+}
+
+// The kinds of operations RunModel performs.
+type opKind int
+
+const (
+	opAdd opKind = iota
+	opTake
+	opPeek
+	opRemove
+	opFilter
+	opClear
+)
+
+// An op is a single operation of a sequence. For Filter, val is the threshold above which elements are removed.
+type op struct {
+	kind opKind
+	val  int
+}
+
+// Returns the operation as it would be written in Go.
+func (o op) String() string {
+	switch o.kind {
+	case opAdd:
+		return fmt.Sprintf("Add(%d)", o.val)
+	case opTake:
+		return "Take()"
+	case opPeek:
+		return "Peek()"
+	case opRemove:
+		return fmt.Sprintf("Remove(%d)", o.val)
+	case opFilter:
+		return fmt.Sprintf("Filter(v > %d)", o.val)
+	default:
+		return "Clear()"
+	}
+}
+
+// Returns the operations joined into a single readable line.
+func formatOps(ops []op) string {
+	names := make([]string, len(ops))
+	for i, o := range ops {
+		names[i] = o.String()
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Returns n random operations. Adds are weighted heavily so that collections grow, and values are kept small so that
+// duplicates are common.
+func randomOps(r *rand.Rand, n int, canPeek bool) []op {
+	ops := make([]op, n)
+	for i := range ops {
+		var kind opKind
+		switch p := r.Intn(100); {
+		case p < 45:
+			kind = opAdd
+		case p < 65:
+			kind = opTake
+		case p < 75:
+			kind = opPeek
+		case p < 88:
+			kind = opRemove
+		case p < 96:
+			kind = opFilter
+		default:
+			kind = opClear
+		}
+
+		if kind == opPeek && !canPeek {
+			kind = opTake
+		}
+		ops[i] = op{kind: kind, val: r.Intn(10)}
+	}
+
+	return ops
+}
+
+// Applies the operations to a fresh collection and the reference model, returning an error describing the first
+// divergence, including panics raised by the collection.
+func runOps(newCollection func() cln.Collection[int], order Ordering, ops []op) (err error) {
+	c := newCollection()
+	m := &model{order: order, newCollection: newCollection}
+
+	step := 0
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("step %d, %s: panic: %v", step, ops[step], r)
+		}
+	}()
+
+	for ; step < len(ops); step++ {
+		if err := m.apply(c, ops[step]); err != nil {
+			return fmt.Errorf("step %d, %s: %w", step, ops[step], err)
+		}
+	}
+
+	return nil
+}
+
+// Returns a smaller sequence of operations that still makes the collection diverge from the model. Operations are
+// dropped one at a time, then the remaining values are lowered towards zero, until neither change keeps the failure.
+func shrink(newCollection func() cln.Collection[int], order Ordering, ops []op) []op {
+	fails := func(candidate []op) bool {
+		return runOps(newCollection, order, candidate) != nil
+	}
+
+	for shrunk := true; shrunk; {
+		shrunk = false
+
+		for i := len(ops) - 1; i >= 0; i-- {
+			candidate := append(append([]op(nil), ops[:i]...), ops[i+1:]...)
+			if fails(candidate) {
+				ops = candidate
+				shrunk = true
+			}
+		}
+
+		for i := range ops {
+			for ops[i].val > 0 {
+				candidate := append([]op(nil), ops...)
+				candidate[i].val--
+				if !fails(candidate) {
+					break
+				}
+				ops = candidate
+				shrunk = true
+			}
+		}
+	}
+
+	return ops
+}
+
+// A model is the slice backed reference a collection is compared against. Values are kept in insertion order.
+type model struct {
+	order         Ordering
+	vals          []int
+	newCollection func() cln.Collection[int]
+}
+
+// Applies the operation to both the collection and the model and returns an error if their results or contents differ.
+func (m *model) apply(c cln.Collection[int], o op) error {
+	switch o.kind {
+	case opAdd:
+		c.Add(o.val)
+		m.vals = append(m.vals, o.val)
+
+	case opTake:
+		got, ok := c.Take()
+		exp, expOk := m.next()
+		if ok != expOk {
+			return fmt.Errorf("Take returned ok = %t but expected %t", ok, expOk)
+		}
+		if ok {
+			if m.order == Unordered {
+				exp = got
+			}
+			if got != exp {
+				return fmt.Errorf("Take returned %d but expected %d", got, exp)
+			}
+			if !m.remove(got, m.order == LIFO) {
+				return fmt.Errorf("Take returned %d which was never added", got)
+			}
+		}
+
+	case opPeek:
+		got, ok := c.(peeker).Peek()
+		exp, expOk := m.next()
+		if ok != expOk || (ok && m.order != Unordered && got != exp) {
+			return fmt.Errorf("Peek returned (%d, %t) but expected (%d, %t)", got, ok, exp, expOk)
+		}
+
+	case opRemove:
+		got := c.Remove(o.val)
+		exp := m.remove(o.val, m.order == LIFO)
+		if got != exp {
+			return fmt.Errorf("Remove returned %t but expected %t", got, exp)
+		}
+
+	case opFilter:
+		c.Filter(func(v int) bool { return v > o.val })
+		kept := m.vals[:0]
+		for _, v := range m.vals {
+			if v <= o.val {
+				kept = append(kept, v)
+			}
+		}
+		m.vals = kept
+
+	case opClear:
+		c.Clear()
+		m.vals = nil
+	}
+
+	return m.compare(c)
+}
+
+// Returns the value the model expects Take to return next, and false if the model is empty.
+func (m *model) next() (int, bool) {
+	if len(m.vals) == 0 {
+		return 0, false
+	}
+
+	switch m.order {
+	case LIFO:
+		return m.vals[len(m.vals)-1], true
+	case Priority:
+		return sorted(m.vals)[0], true
+	default:
+		return m.vals[0], true
+	}
+}
+
+// Removes a single instance of val from the model - the newest when fromTop is set, otherwise the oldest - and returns
+// true if there was one.
+func (m *model) remove(val int, fromTop bool) bool {
+	i := -1
+	for j, v := range m.vals {
+		if v == val {
+			i = j
+			if !fromTop {
+				break
+			}
+		}
+	}
+
+	if i == -1 {
+		return false
+	}
+
+	m.vals = append(m.vals[:i], m.vals[i+1:]...)
+	return true
+}
+
+// Returns an error if the collection's invariants, size or elements differ from the model.
+func (m *model) compare(c cln.Collection[int]) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if c.Size() != len(m.vals) {
+		return fmt.Errorf("Size is %d but model holds %d elements %v", c.Size(), len(m.vals), m.vals)
+	}
+
+	got := drain(c)
+	if m.order == FIFO || m.order == LIFO {
+		if !equal(got, m.vals) {
+			return fmt.Errorf("collection holds %v but model holds %v", got, m.vals)
+		}
+
+		rebuilt := m.newCollection()
+		rebuilt.Add(got...)
+		if c.String() != rebuilt.String() {
+			return fmt.Errorf("String returned %q but an equal collection returns %q", c.String(), rebuilt.String())
+		}
+		return nil
+	}
+
+	if !equal(sorted(got), sorted(m.vals)) {
+		return fmt.Errorf("collection holds %v but model holds %v in any order", got, m.vals)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package clntest
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/SMTanami/collections/cln"
+)
+
+// A forgetfulQueue is a queue whose Remove reports success without removing anything.
+type forgetfulQueue struct {
+	cln.Collection[int]
+}
+
+func (forgetfulQueue) Remove(int) bool {
+	return true
+}
+
+func TestShrink(t *testing.T) {
+	t.Run("Shrink Should Reduce Failing Sequence to Minimal Reproduction", func(t *testing.T) {
+		newCollection := func() cln.Collection[int] {
+			return forgetfulQueue{cln.NewQueue[int]()}
+		}
+		ops := []op{{opAdd, 4}, {opTake, 0}, {opAdd, 7}, {opAdd, 3}, {opClear, 0}, {opAdd, 9}, {opRemove, 9}, {opAdd, 1}}
+
+		if runOps(newCollection, FIFO, ops) == nil {
+			t.Fatal("Expected sequence to fail against forgetful queue!")
+		}
+
+		shrunk := shrink(newCollection, FIFO, ops)
+		exp := []op{{opRemove, 0}}
+		if formatOps(shrunk) != formatOps(exp) {
+			t.Errorf("\nExpected: %s\nGot: %s", formatOps(exp), formatOps(shrunk))
+		}
+	})
+}
+
+// Returns true if any of the random sequences RunModel checks by default makes the collection diverge from the model of
+// the given ordering.
+func diverges(newCollection func() cln.Collection[int], order Ordering) bool {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		if runOps(newCollection, order, randomOps(r, 1+r.Intn(64), false)) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestRunModel(t *testing.T) {
+	t.Run("RunModel Should Pass for Queue", func(t *testing.T) {
+		RunModel(t, func() cln.Collection[int] {
+			return cln.NewQueue[int]()
+		}, FIFO, ModelConfig{})
+	})
+
+	t.Run("RunModel Should Pass for Priority Collection", func(t *testing.T) {
+		RunModel(t, newPriorityQueue, Priority, ModelConfig{})
+	})
+
+	t.Run("RunModel Should Pass for Unordered Collection", func(t *testing.T) {
+		RunModel(t, newBag, Unordered, ModelConfig{})
+	})
+
+	t.Run("Priority Model Should Catch Collection Taking Largest Element", func(t *testing.T) {
+		newCollection := func() cln.Collection[int] {
+			return maxFirstQueue{cln.NewQueue[int]()}
+		}
+
+		if !diverges(newCollection, Priority) {
+			t.Error("No sequence made the max-first queue diverge from the priority model!")
+		}
+	})
+
+	t.Run("Unordered Model Should Catch Collection Taking Element It Doesn't Hold", func(t *testing.T) {
+		newCollection := func() cln.Collection[int] {
+			return miscountingBag{bag{cln.NewQueue[int]()}}
+		}
+
+		if !diverges(newCollection, Unordered) {
+			t.Error("No sequence made the miscounting bag diverge from the unordered model!")
+		}
+	})
+}